@@ -15,8 +15,19 @@ var (
 )
 
 type Config struct {
-	OnlyRunningOnStartup bool   `yaml:"onlyRunningOnStartup"`
-	InitialAmountOfLogs  string `yaml:"initialAmountOfLogs"`
+	OnlyRunningOnStartup bool          `yaml:"onlyRunningOnStartup"`
+	InitialAmountOfLogs  string        `yaml:"initialAmountOfLogs"`
+	StopTimeout          int           `yaml:"stopTimeout"`
+	StatsInterval        int           `yaml:"statsInterval"`
+	Hosts                []HostProfile `yaml:"hosts"`
+}
+
+// HostProfile is a Docker host that can be switched to next to the Docker
+// contexts.
+type HostProfile struct {
+	Name     string `yaml:"name"`
+	Host     string `yaml:"host"`
+	CertPath string `yaml:"certPath"`
 }
 
 type Theme struct {
@@ -0,0 +1,29 @@
+package docker
+
+import (
+	"context"
+	"log"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+)
+
+const (
+	ComposeProjectLabel = "com.docker.compose.project"
+	ComposeServiceLabel = "com.docker.compose.service"
+)
+
+func (dc *DockerWrapper) GetProjectContainers(project string) []types.Container {
+	projectFilter := filters.NewArgs(filters.Arg("label", ComposeProjectLabel+"="+project))
+
+	containers, err := dc.api().ContainerList(context.Background(), container.ListOptions{
+		All:     true,
+		Filters: projectFilter,
+	})
+	if err != nil {
+		log.Printf("Error listing containers for project %s: %v", project, err)
+		return nil
+	}
+	return containers
+}
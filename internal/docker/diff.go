@@ -0,0 +1,67 @@
+package docker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+// maxStatChanges caps the number of changed paths whose size is looked up,
+// as every lookup is a request to the daemon.
+const maxStatChanges = 1000
+
+type FileChange struct {
+	Path  string
+	Kind  container.ChangeType
+	IsDir bool
+	// Size is -1 for deleted paths and when it was not looked up.
+	Size int64
+}
+
+// GetContainerChanges returns the changes to the filesystem of a container
+// compared to its image, with the size of added and modified files.
+func (dc *DockerWrapper) GetContainerChanges(id string) ([]FileChange, error) {
+	ctx := context.Background()
+	diff, err := dc.api().ContainerDiff(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]FileChange, len(diff))
+	for i, change := range diff {
+		changes[i] = FileChange{Path: change.Path, Kind: change.Kind, Size: -1}
+	}
+
+	var wg sync.WaitGroup
+	lookups := make(chan int)
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range lookups {
+				stat, err := dc.api().ContainerStatPath(ctx, id, changes[i].Path)
+				if err != nil {
+					continue
+				}
+				changes[i].IsDir = stat.Mode.IsDir()
+				if !changes[i].IsDir {
+					changes[i].Size = stat.Size
+				}
+			}
+		}()
+	}
+
+	statted := 0
+	for i := range changes {
+		if changes[i].Kind == container.ChangeDelete || statted >= maxStatChanges {
+			continue
+		}
+		lookups <- i
+		statted++
+	}
+	close(lookups)
+	wg.Wait()
+
+	return changes, nil
+}
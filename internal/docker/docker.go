@@ -10,6 +10,7 @@ import (
 	"main/internal/config"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alecthomas/chroma/quick"
@@ -32,8 +33,15 @@ type DockerImage struct {
 }
 
 type DockerWrapper struct {
+	// clientMu guards client and endpoint, which Connect replaces while
+	// other goroutines use the client.
+	clientMu        sync.RWMutex
 	client          *client.Client
+	endpoint        Endpoint
 	IsClientCreated bool
+
+	stateMu sync.Mutex
+	state   ConnectionState
 }
 
 type ContainerInfo struct {
@@ -44,68 +52,95 @@ type ContainerInfo struct {
 	State       string
 	Uptime      time.Duration
 	Image       string
+	Health      string
 }
 
 type LogsSettings struct {
 	initialAmountOfLogs string
 }
 
-var logsSettings LogsSettings
+type ContainerSettings struct {
+	stopTimeout *int
+}
+
+var (
+	logsSettings      LogsSettings
+	containerSettings ContainerSettings
+)
 
 func (dc *DockerWrapper) NewClient(config config.Config) {
-	var err error
-	dc.client, err = client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	endpoint := CurrentEndpoint(LoadEndpoints(config.Hosts))
+	apiClient, err := newAPIClient(endpoint)
 	if err != nil {
 		panic(err.Error())
 	}
+	dc.clientMu.Lock()
+	dc.client = apiClient
+	dc.endpoint = endpoint
 	dc.IsClientCreated = true
+	dc.clientMu.Unlock()
 	logsSettings = LogsSettings{
 		initialAmountOfLogs: config.InitialAmountOfLogs,
 	}
+	if config.StopTimeout > 0 {
+		stopTimeout := config.StopTimeout
+		containerSettings.stopTimeout = &stopTimeout
+	}
 }
 
 func (dc *DockerWrapper) CloseClient() {
+	dc.clientMu.Lock()
+	defer dc.clientMu.Unlock()
+
 	dc.client.Close()
 	dc.IsClientCreated = false
 }
 
+// api returns the client of the current endpoint.
+func (dc *DockerWrapper) api() *client.Client {
+	dc.clientMu.RLock()
+	defer dc.clientMu.RUnlock()
+
+	return dc.client
+}
+
 func (dc *DockerWrapper) GetContainers(allContainers bool) []types.Container {
-	containers, err := dc.client.ContainerList(context.Background(), container.ListOptions{All: allContainers})
+	containers, err := dc.ListContainers(context.Background(), allContainers)
 	if err != nil {
-		panic(err)
+		log.Printf("Error listing containers: %v", err)
+		return nil
 	}
 	return containers
 }
 
+func (dc *DockerWrapper) ListContainers(ctx context.Context, allContainers bool) ([]types.Container, error) {
+	return dc.api().ContainerList(ctx, container.ListOptions{All: allContainers})
+}
+
 func (dc *DockerWrapper) GetImages() []image.Summary {
-	images, err := dc.client.ImageList(
+	images, err := dc.api().ImageList(
 		context.Background(),
 		image.ListOptions{},
 	)
 	if err != nil {
-		panic(err)
+		log.Printf("Error listing images: %v", err)
+		return nil
 	}
 	return images
 }
 
 func (dc *DockerWrapper) GetDockerVersion() string {
-	ver, _ := dc.client.ServerVersion(context.Background())
+	ver, _ := dc.api().ServerVersion(context.Background())
 	return ver.Version
 }
 
 func (dc *DockerWrapper) GetDockerVolumes() []*volume.Volume {
-	dockerVolumes, _ := dc.client.VolumeList(context.Background(), volume.ListOptions{})
+	dockerVolumes, _ := dc.api().VolumeList(context.Background(), volume.ListOptions{})
 	return dockerVolumes.Volumes
 }
 
-func (dc *DockerWrapper) GetAttributes(containerID string) string {
-	containerInfo, _ := dc.client.ContainerInspect(context.Background(), containerID)
-	infoJSON, _ := json.MarshalIndent(containerInfo, "", "  ")
-	return string(infoJSON)
-}
-
 func (dc *DockerWrapper) GetEnvironmentVariables(containerID string) string {
-	containerInfo, _ := dc.client.ContainerInspect(context.Background(), containerID)
+	containerInfo, _ := dc.api().ContainerInspect(context.Background(), containerID)
 	envVars, _ := json.MarshalIndent(containerInfo.Config.Env, "", "  ")
 	return string(envVars)
 }
@@ -113,24 +148,55 @@ func (dc *DockerWrapper) GetEnvironmentVariables(containerID string) string {
 func (dc *DockerWrapper) ListenForEvents(ctx context.Context, eventChan chan<- events.Message) {
 	eventFilter := filters.NewArgs()
 	eventFilter.Add("type", "container")
+	dc.listenForEvents(ctx, eventFilter, eventChan)
+}
 
-	messages, errs := dc.client.Events(ctx, events.ListOptions{
-		Filters: eventFilter,
-	})
+// ListenForAllEvents streams the events of every type, e.g. for images,
+// volumes, networks and the daemon itself.
+func (dc *DockerWrapper) ListenForAllEvents(ctx context.Context, eventChan chan<- events.Message) {
+	dc.listenForEvents(ctx, filters.NewArgs(), eventChan)
+}
+
+// listenForEvents forwards events until ctx is done. When the stream breaks,
+// e.g. because the daemon restarts, it subscribes again with exponential
+// backoff and replays the events since the stream broke.
+func (dc *DockerWrapper) listenForEvents(ctx context.Context, eventFilter filters.Args, eventChan chan<- events.Message) {
+	defer close(eventChan)
+
+	options := events.ListOptions{Filters: eventFilter}
+	backoff := initialBackoff
+	for {
+		messages, errs := dc.api().Events(ctx, options)
+		err := forwardEvents(ctx, messages, errs, eventChan, &backoff)
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Printf("Error while listening to Docker events, retrying in %s: %v", backoff, err)
+		options.Since = formatSince(time.Now())
+		if !waitBackoff(ctx, backoff) {
+			return
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
 
+// forwardEvents forwards the events of one subscription until it fails. The
+// backoff is reset once the subscription delivers events again.
+func forwardEvents(ctx context.Context, messages <-chan events.Message, errs <-chan error, eventChan chan<- events.Message, backoff *time.Duration) error {
 	for {
 		select {
 		case event := <-messages:
-			eventChan <- event
-		case err := <-errs:
-			if err != nil {
-				log.Printf("Error while listening to Docker events: %v", err)
-				close(eventChan)
-				return
+			*backoff = initialBackoff
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+				return ctx.Err()
 			}
+		case err := <-errs:
+			return err
 		case <-ctx.Done():
-			close(eventChan)
-			return
+			return ctx.Err()
 		}
 	}
 }
@@ -150,19 +216,19 @@ func (dc *DockerWrapper) CreateContainerShell(ctx context.Context, containerID s
 			Tty:          true,
 			Cmd:          []string{command},
 		}
-		resp, err := dc.client.ContainerExecCreate(ctx, containerID, execConfig)
+		resp, err := dc.api().ContainerExecCreate(ctx, containerID, execConfig)
 		if err == nil {
 			shell = resp
 			break
 		}
 	}
 
-	attachResp, err := dc.client.ContainerExecAttach(ctx, shell.ID, container.ExecAttachOptions{Tty: true})
+	attachResp, err := dc.api().ContainerExecAttach(ctx, shell.ID, container.ExecAttachOptions{Tty: true})
 	if err != nil {
 		return err
 	}
 
-	err = dc.client.ContainerExecStart(ctx, shell.ID, container.ExecAttachOptions{Tty: true})
+	err = dc.api().ContainerExecStart(ctx, shell.ID, container.ExecAttachOptions{Tty: true})
 	if err != nil {
 		return err
 	}
@@ -199,18 +265,18 @@ func (dc *DockerWrapper) updateTerminalSize(ctx context.Context, execID string)
 		return
 	}
 
-	dc.client.ContainerExecResize(ctx, execID, container.ResizeOptions{
+	dc.api().ContainerExecResize(ctx, execID, container.ResizeOptions{
 		Height: uint(height),
 		Width:  uint(width),
 	})
 }
 func (dc *DockerWrapper) GetContainerInfo(id string) (*ContainerInfo, error) {
-	container, err := dc.client.ContainerInspect(context.Background(), id)
+	container, err := dc.api().ContainerInspect(context.Background(), id)
 	if err != nil {
 		return nil, err
 	}
 
-	stats, err := dc.client.ContainerStatsOneShot(context.Background(), id)
+	stats, err := dc.api().ContainerStatsOneShot(context.Background(), id)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +297,11 @@ func (dc *DockerWrapper) GetContainerInfo(id string) (*ContainerInfo, error) {
 
 	uptime := time.Since(startTime).Round(time.Second)
 
+	var health string
+	if container.State.Health != nil {
+		health = container.State.Health.Status
+	}
+
 	return &ContainerInfo{
 		ID:          container.ID[:12],
 		Name:        strings.TrimPrefix(container.Name, "/"),
@@ -239,14 +310,19 @@ func (dc *DockerWrapper) GetContainerInfo(id string) (*ContainerInfo, error) {
 		State:       container.State.Status,
 		Uptime:      uptime,
 		Image:       container.Config.Image,
+		Health:      health,
 	}, nil
 }
 
 func calculateCPUUsage(stats *types.StatsJSON) float64 {
 	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage - stats.PreCPUStats.CPUUsage.TotalUsage)
 	systemDelta := float64(stats.CPUStats.SystemUsage - stats.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0.0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
 	if systemDelta > 0.0 && cpuDelta > 0.0 {
-		return (cpuDelta / systemDelta) * float64(len(stats.CPUStats.CPUUsage.PercpuUsage)) * 100.0
+		return (cpuDelta / systemDelta) * onlineCPUs * 100.0
 	}
 	return 0.0
 }
@@ -273,46 +349,33 @@ func (dc *DockerWrapper) ListenForNewLogs(ctx context.Context, id string, app *t
 		textView.ScrollToEnd()
 	})
 
-	liveLogs, err := dc.startLogStream(id)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error fetching live logs: %v\n", err)
-		return
-	}
-	defer liveLogs.Close()
-
 	logChan := make(chan string, 1000)
 
 	go func() {
-		header := make([]byte, 8)
-		buffer := &bytes.Buffer{}
-
+		since := time.Now()
+		backoff := initialBackoff
 		for {
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				_, err := io.ReadFull(liveLogs, header)
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					fmt.Fprintf(os.Stderr, "Error reading log header: %v\n", err)
-					return
-				}
-
-				logMessage, err := dc.readLogMessage(liveLogs, header)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "Error reading log message: %v\n", err)
-					return
+			liveLogs, err := dc.startLogStream(ctx, id, since)
+			if err == nil {
+				var received bool
+				received, err = dc.readLogStream(ctx, liveLogs, logChan)
+				liveLogs.Close()
+				if received {
+					backoff = initialBackoff
 				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
 
-				buffer.Write(logMessage)
-
-				if buffer.Len() > 1024 || bytes.Count(logMessage, []byte{'\n'}) > 0 {
-					logChan <- buffer.String()
-					buffer.Reset()
-				}
+			// The stream ends when the container stops or the daemon goes
+			// away, follow it again once either is back.
+			log.Printf("Log stream of %s ended, reconnecting in %s: %v", id, backoff, err)
+			since = time.Now()
+			if !waitBackoff(ctx, backoff) {
+				return
 			}
+			backoff = nextBackoff(backoff)
 		}
 	}()
 
@@ -360,7 +423,7 @@ func (dc *DockerWrapper) fetchContainerLogs(id string, follow bool, since string
 		Tail:       logsSettings.initialAmountOfLogs,
 	}
 
-	out, err := dc.client.ContainerLogs(context.Background(), id, logOptions)
+	out, err := dc.api().ContainerLogs(context.Background(), id, logOptions)
 	if err != nil {
 		return "", err
 	}
@@ -388,15 +451,15 @@ func (dc *DockerWrapper) fetchContainerLogs(id string, follow bool, since string
 	return logBuffer.String(), nil
 }
 
-func (dc *DockerWrapper) startLogStream(id string) (io.ReadCloser, error) {
+func (dc *DockerWrapper) startLogStream(ctx context.Context, id string, since time.Time) (io.ReadCloser, error) {
 	logOptions := container.LogsOptions{
 		ShowStdout: true,
 		ShowStderr: true,
 		Follow:     true,
-		Since:      time.Now().Format(time.RFC3339),
+		Since:      formatSince(since),
 	}
 
-	out, err := dc.client.ContainerLogs(context.Background(), id, logOptions)
+	out, err := dc.api().ContainerLogs(ctx, id, logOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -404,6 +467,37 @@ func (dc *DockerWrapper) startLogStream(id string) (io.ReadCloser, error) {
 	return out, nil
 }
 
+// readLogStream sends the messages of a log stream to logChan until the
+// stream ends, and reports whether any message was received.
+func (dc *DockerWrapper) readLogStream(ctx context.Context, out io.Reader, logChan chan<- string) (bool, error) {
+	header := make([]byte, 8)
+	buffer := &bytes.Buffer{}
+	received := false
+
+	for {
+		if _, err := io.ReadFull(out, header); err != nil {
+			return received, err
+		}
+
+		logMessage, err := dc.readLogMessage(out, header)
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		buffer.Write(logMessage)
+
+		if buffer.Len() > 1024 || bytes.Count(logMessage, []byte{'\n'}) > 0 {
+			select {
+			case logChan <- buffer.String():
+			case <-ctx.Done():
+				return received, ctx.Err()
+			}
+			buffer.Reset()
+		}
+	}
+}
+
 func (dc *DockerWrapper) streamLogs(ctx context.Context, out io.ReadCloser, app *tview.Application, textView *tview.TextView, scrollOnNewLogEntry *bool) {
 	header := make([]byte, 8)
 	for {
@@ -467,8 +561,8 @@ func (dc *DockerWrapper) highlightLogs(logs string) string {
 	return highlightedBuffer.String()
 }
 
-func (dc *DockerWrapper) PauseContainer(id string) {
-	dc.client.ContainerPause(context.Background(), id)
+func (dc *DockerWrapper) PauseContainer(id string) error {
+	return dc.api().ContainerPause(context.Background(), id)
 }
 
 func (dc *DockerWrapper) PauseContainers(ids []string) {
@@ -477,8 +571,8 @@ func (dc *DockerWrapper) PauseContainers(ids []string) {
 	}
 }
 
-func (dc *DockerWrapper) UnpauseContainer(id string) {
-	dc.client.ContainerUnpause(context.Background(), id)
+func (dc *DockerWrapper) UnpauseContainer(id string) error {
+	return dc.api().ContainerUnpause(context.Background(), id)
 }
 
 func (dc *DockerWrapper) UnpauseContainers(ids []string) {
@@ -488,33 +582,88 @@ func (dc *DockerWrapper) UnpauseContainers(ids []string) {
 }
 
 func (dc *DockerWrapper) StartContainer(id string) error {
-	err := dc.client.ContainerStart(context.Background(), id, container.StartOptions{})
+	err := dc.api().ContainerStart(context.Background(), id, container.StartOptions{})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (dc *DockerWrapper) StartContainers(ids []string) {
+func (dc *DockerWrapper) StartContainers(ids []string) []error {
+	var errs []error
 	for _, id := range ids {
-		dc.StartContainer(id)
+		if err := dc.StartContainer(id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (dc *DockerWrapper) StopContainer(id string) error {
+	return dc.api().ContainerStop(context.Background(), id, stopOptions())
+}
+
+func (dc *DockerWrapper) StopContainers(ids []string) []error {
+	var errs []error
+	for _, id := range ids {
+		if err := dc.StopContainer(id); err != nil {
+			errs = append(errs, err)
+		}
 	}
+	return errs
 }
 
-func (dc *DockerWrapper) StopContainer(id string) {
-	dc.client.ContainerStop(context.Background(), id, container.StopOptions{})
+func (dc *DockerWrapper) RestartContainer(id string) error {
+	return dc.api().ContainerRestart(context.Background(), id, stopOptions())
 }
 
-func (dc *DockerWrapper) StopContainers(ids []string) {
+func (dc *DockerWrapper) RestartContainers(ids []string) []error {
+	var errs []error
 	for _, id := range ids {
-		dc.StopContainer(id)
+		if err := dc.RestartContainer(id); err != nil {
+			errs = append(errs, err)
+		}
 	}
+	return errs
+}
+
+func (dc *DockerWrapper) KillContainer(id, signal string) error {
+	return dc.api().ContainerKill(context.Background(), id, signal)
+}
+
+func stopOptions() container.StopOptions {
+	return container.StopOptions{Timeout: containerSettings.stopTimeout}
 }
 
 func (dc *DockerWrapper) RemoveContainer(id string) error {
-	err := dc.client.ContainerRemove(context.Background(), id, container.RemoveOptions{})
+	err := dc.api().ContainerRemove(context.Background(), id, container.RemoveOptions{})
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (dc *DockerWrapper) RemoveContainers(ids []string) []error {
+	var errs []error
+	for _, id := range ids {
+		if err := dc.RemoveContainer(id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (dc *DockerWrapper) InspectContainer(containerID string) (types.ContainerJSON, error) {
+	return dc.api().ContainerInspect(context.Background(), containerID)
+}
+
+func (dc *DockerWrapper) RenameContainer(id, name string) error {
+	return dc.api().ContainerRename(context.Background(), id, name)
+}
+
+// UpdateContainer changes the resource limits and restart policy of a
+// container and returns the warnings of the daemon.
+func (dc *DockerWrapper) UpdateContainer(id string, update container.UpdateConfig) ([]string, error) {
+	resp, err := dc.api().ContainerUpdate(context.Background(), id, update)
+	return resp.Warnings, err
+}
@@ -0,0 +1,231 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"main/internal/config"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/tlsconfig"
+)
+
+const (
+	EndpointSourceEnvironment = "environment"
+	EndpointSourceContext     = "context"
+	EndpointSourceConfig      = "config"
+
+	defaultContextName = "default"
+	connectTimeout     = 10 * time.Second
+)
+
+// Endpoint is a Docker daemon gocker can connect to, either from a Docker
+// context, a host profile in the gocker config or the environment.
+type Endpoint struct {
+	Name          string
+	Host          string
+	Source        string
+	TLSDir        string
+	SkipTLSVerify bool
+}
+
+type contextMetadata struct {
+	Name      string
+	Endpoints map[string]struct {
+		Host          string
+		SkipTLSVerify bool
+	}
+}
+
+func dockerConfigDir() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return dir
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".docker")
+}
+
+// LoadEndpoints returns the default endpoint from the environment, followed by
+// the Docker contexts and the host profiles of the gocker config.
+func LoadEndpoints(profiles []config.HostProfile) []Endpoint {
+	defaultHost := os.Getenv("DOCKER_HOST")
+	if defaultHost == "" {
+		defaultHost = client.DefaultDockerHost
+	}
+	endpoints := []Endpoint{{Name: defaultContextName, Host: defaultHost, Source: EndpointSourceEnvironment}}
+
+	contextsDir := filepath.Join(dockerConfigDir(), "contexts")
+	metaFiles, _ := filepath.Glob(filepath.Join(contextsDir, "meta", "*", "meta.json"))
+	var contexts []Endpoint
+	for _, metaFile := range metaFiles {
+		data, err := os.ReadFile(metaFile)
+		if err != nil {
+			log.Printf("Error reading Docker context %s: %v", metaFile, err)
+			continue
+		}
+		var metadata contextMetadata
+		if err := json.Unmarshal(data, &metadata); err != nil {
+			log.Printf("Error parsing Docker context %s: %v", metaFile, err)
+			continue
+		}
+		dockerEndpoint, exists := metadata.Endpoints["docker"]
+		if !exists || dockerEndpoint.Host == "" {
+			continue
+		}
+
+		contextID := filepath.Base(filepath.Dir(metaFile))
+		contexts = append(contexts, Endpoint{
+			Name:          metadata.Name,
+			Host:          dockerEndpoint.Host,
+			Source:        EndpointSourceContext,
+			TLSDir:        filepath.Join(contextsDir, "tls", contextID, "docker"),
+			SkipTLSVerify: dockerEndpoint.SkipTLSVerify,
+		})
+	}
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+	endpoints = append(endpoints, contexts...)
+
+	for _, profile := range profiles {
+		endpoints = append(endpoints, Endpoint{
+			Name:   profile.Name,
+			Host:   profile.Host,
+			Source: EndpointSourceConfig,
+			TLSDir: expandHome(profile.CertPath),
+		})
+	}
+	return endpoints
+}
+
+// CurrentEndpoint returns the endpoint the docker CLI would use: DOCKER_HOST,
+// then DOCKER_CONTEXT, then the current context of the docker config.
+func CurrentEndpoint(endpoints []Endpoint) Endpoint {
+	name := defaultContextName
+	if os.Getenv("DOCKER_HOST") == "" {
+		if contextName := os.Getenv("DOCKER_CONTEXT"); contextName != "" {
+			name = contextName
+		} else if data, err := os.ReadFile(filepath.Join(dockerConfigDir(), "config.json")); err == nil {
+			var dockerConfig struct {
+				CurrentContext string `json:"currentContext"`
+			}
+			if json.Unmarshal(data, &dockerConfig) == nil && dockerConfig.CurrentContext != "" {
+				name = dockerConfig.CurrentContext
+			}
+		}
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Name == name && endpoint.Source != EndpointSourceConfig {
+			return endpoint
+		}
+	}
+	return endpoints[0]
+}
+
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, _ := os.UserHomeDir()
+		return filepath.Join(home, path[1:])
+	}
+	return path
+}
+
+func newAPIClient(endpoint Endpoint) (*client.Client, error) {
+	if isSSHHost(endpoint.Host) {
+		return newSSHClient(endpoint.Host)
+	}
+	if endpoint.Source == EndpointSourceEnvironment {
+		return client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	}
+
+	opts := []client.Opt{client.WithAPIVersionNegotiation()}
+	if tlsOptions, enabled := endpointTLSOptions(endpoint); enabled {
+		tlsConfig, err := tlsconfig.Client(tlsOptions)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithHTTPClient(&http.Client{
+			Transport:     &http.Transport{TLSClientConfig: tlsConfig},
+			CheckRedirect: client.CheckRedirect,
+		}))
+	}
+	opts = append(opts, client.WithHost(endpoint.Host))
+	return client.NewClientWithOpts(opts...)
+}
+
+// endpointTLSOptions returns the TLS options of an endpoint, which uses TLS
+// when its TLS directory holds any certificates or verification is skipped.
+func endpointTLSOptions(endpoint Endpoint) (tlsconfig.Options, bool) {
+	options := tlsconfig.Options{InsecureSkipVerify: endpoint.SkipTLSVerify}
+	enabled := endpoint.SkipTLSVerify
+	if endpoint.TLSDir == "" {
+		return options, enabled
+	}
+
+	file := func(name string) string {
+		path := filepath.Join(endpoint.TLSDir, name)
+		if _, err := os.Stat(path); err != nil {
+			return ""
+		}
+		enabled = true
+		return path
+	}
+	options.CAFile = file("ca.pem")
+	options.CertFile = file("cert.pem")
+	options.KeyFile = file("key.pem")
+	return options, enabled
+}
+
+// Connect switches the client to another endpoint. The current client is
+// kept when the new endpoint can not be reached.
+func (dc *DockerWrapper) Connect(endpoint Endpoint) error {
+	apiClient, err := newAPIClient(endpoint)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+	if _, err := apiClient.Ping(ctx); err != nil {
+		apiClient.Close()
+		return fmt.Errorf("connecting to %s failed: %w", endpoint.Name, err)
+	}
+
+	dc.clientMu.Lock()
+	previous := dc.client
+	dc.client = apiClient
+	dc.endpoint = endpoint
+	dc.IsClientCreated = true
+	dc.clientMu.Unlock()
+
+	dc.setConnectionState(ConnectionState{Status: StatusConnected})
+	// Closing only drops idle connections, requests still running on the
+	// previous client finish against the previous daemon.
+	if previous != nil {
+		previous.Close()
+	}
+	return nil
+}
+
+func (dc *DockerWrapper) Endpoint() Endpoint {
+	dc.clientMu.RLock()
+	defer dc.clientMu.RUnlock()
+
+	return dc.endpoint
+}
+
+// Ping checks whether the daemon of the current endpoint can be reached.
+func (dc *DockerWrapper) Ping() error {
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+	_, err := dc.api().Ping(ctx)
+	return err
+}
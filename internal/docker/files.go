@@ -0,0 +1,335 @@
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+type FileEntry struct {
+	Name   string
+	IsDir  bool
+	IsLink bool
+	// Size is -1 when it is not known, i.e. for listings made with a shell.
+	Size int64
+}
+
+// listDirectoryScript prints the type and name of every entry in the
+// directory passed as first argument. It only relies on POSIX sh builtins, so
+// it also works in busybox based images.
+const listDirectoryScript = `cd "$1" || exit 1
+for f in * .*; do
+	case "$f" in .|..) continue;; esac
+	[ -e "$f" ] || [ -L "$f" ] || continue
+	if [ -L "$f" ]; then t=l; elif [ -d "$f" ]; then t=d; else t=f; fi
+	printf '%s\t%s\n' "$t" "$f"
+done`
+
+// ListDirectory lists a directory of a container. Running containers are
+// listed with a shell, which is cheap; stopped containers and images without
+// a shell fall back to reading the archive of the directory.
+func (dc *DockerWrapper) ListDirectory(id, dir string) ([]FileEntry, error) {
+	entries, err := dc.listDirectoryWithShell(id, dir)
+	if err != nil {
+		entries, err = dc.listDirectoryFromArchive(id, dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].IsDir != entries[j].IsDir {
+			return entries[i].IsDir
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+func (dc *DockerWrapper) listDirectoryWithShell(id, dir string) ([]FileEntry, error) {
+	output, err := dc.runCommand(id, []string{"sh", "-c", listDirectoryScript, "sh", dir})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []FileEntry
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		kind, name, found := strings.Cut(line, "\t")
+		if !found {
+			continue
+		}
+		entries = append(entries, FileEntry{Name: name, IsDir: kind == "d", IsLink: kind == "l", Size: -1})
+	}
+	return entries, nil
+}
+
+func (dc *DockerWrapper) listDirectoryFromArchive(id, dir string) ([]FileEntry, error) {
+	reader, _, err := dc.api().CopyFromContainer(context.Background(), id, dir)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	archive := tar.NewReader(reader)
+	root, err := archive.Next()
+	if err != nil {
+		return nil, err
+	}
+	if root.Typeflag != tar.TypeDir {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	prefix := strings.TrimSuffix(root.Name, "/") + "/"
+
+	var entries []FileEntry
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(header.Name, prefix), "/")
+		if name == "" || strings.Contains(name, "/") {
+			continue
+		}
+		entries = append(entries, FileEntry{
+			Name:   name,
+			IsDir:  header.Typeflag == tar.TypeDir,
+			IsLink: header.Typeflag == tar.TypeSymlink,
+			Size:   header.Size,
+		})
+	}
+}
+
+// ReadFile returns up to limit bytes of a regular file in a container and
+// whether the file was truncated.
+func (dc *DockerWrapper) ReadFile(id, filePath string, limit int64) ([]byte, bool, error) {
+	reader, _, err := dc.api().CopyFromContainer(context.Background(), id, filePath)
+	if err != nil {
+		return nil, false, err
+	}
+	defer reader.Close()
+
+	archive := tar.NewReader(reader)
+	header, err := archive.Next()
+	if err != nil {
+		return nil, false, err
+	}
+	if header.Typeflag != tar.TypeReg {
+		return nil, false, fmt.Errorf("%s is not a regular file", filePath)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(archive, limit))
+	if err != nil {
+		return nil, false, err
+	}
+	return content, header.Size > limit, nil
+}
+
+// DownloadPath copies a file or directory from a container into localDir and
+// returns the local path of the copy. Links pointing out of localDir are
+// skipped, and nothing is written through a link, so an archive can not
+// write files outside of localDir.
+func (dc *DockerWrapper) DownloadPath(id, containerPath, localDir string) (string, error) {
+	reader, _, err := dc.api().CopyFromContainer(context.Background(), id, containerPath)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(localDir, 0o755); err != nil {
+		return "", err
+	}
+
+	archive := tar.NewReader(reader)
+	var root string
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return filepath.Join(localDir, root), nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		name := filepath.FromSlash(path.Clean("/" + header.Name))[1:]
+		if root == "" {
+			root = strings.SplitN(name, string(filepath.Separator), 2)[0]
+		}
+		target, err := localTarget(localDir, name)
+		if err != nil {
+			return "", err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return "", err
+			}
+		case tar.TypeReg:
+			if err := writeLocalFile(target, archive, header.FileInfo().Mode().Perm()); err != nil {
+				return "", err
+			}
+		case tar.TypeSymlink:
+			if !linkInside(localDir, target, header.Linkname) {
+				log.Printf("Skipping link %s -> %s, it points out of %s", name, header.Linkname, localDir)
+				continue
+			}
+			os.Remove(target)
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return "", err
+			}
+		}
+	}
+}
+
+// localTarget returns where an archive entry is written in localDir. The
+// existing directories between localDir and the entry must not be links, so a
+// link from the archive can not redirect later entries.
+func localTarget(localDir, name string) (string, error) {
+	parts := strings.Split(name, string(filepath.Separator))
+	dir := localDir
+	for _, part := range parts[:len(parts)-1] {
+		dir = filepath.Join(dir, part)
+		info, err := os.Lstat(dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("refusing to write %s through the link %s", name, dir)
+		}
+	}
+	return filepath.Join(localDir, name), nil
+}
+
+// linkInside reports whether a link created at target resolves inside
+// localDir. The link must be relative and without ".." elements, so it only
+// points below the directory it is created in, and none of the paths it
+// passes may already be a link, which could point anywhere.
+func linkInside(localDir, target, link string) bool {
+	if link == "" || filepath.IsAbs(link) {
+		return false
+	}
+	for _, part := range strings.Split(link, string(filepath.Separator)) {
+		if part == ".." {
+			return false
+		}
+	}
+	parts := strings.Split(filepath.Clean(link), string(filepath.Separator))
+	if relative, err := filepath.Rel(localDir, target); err != nil || !filepath.IsLocal(relative) {
+		return false
+	}
+
+	dir := filepath.Dir(target)
+	for _, part := range parts {
+		dir = filepath.Join(dir, part)
+		info, err := os.Lstat(dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			return true
+		}
+		if err != nil || info.Mode()&os.ModeSymlink != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLocalFile(target string, content io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	// O_EXCL does not follow a link at target, existing files are replaced.
+	os.Remove(target)
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// UploadPath copies a local file or directory into a directory of a
+// container.
+func (dc *DockerWrapper) UploadPath(id, localPath, containerDir string) error {
+	localPath = filepath.Clean(localPath)
+	if _, err := os.Stat(localPath); err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeTar(writer, localPath))
+	}()
+	defer reader.Close()
+
+	return dc.api().CopyToContainer(context.Background(), id, containerDir, reader, container.CopyToContainerOptions{})
+}
+
+// writeTar writes localPath to an archive, with names relative to the parent
+// directory of localPath.
+func writeTar(w io.Writer, localPath string) error {
+	archive := tar.NewWriter(w)
+	parent := filepath.Dir(localPath)
+
+	err := filepath.Walk(localPath, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(file); err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(parent, file)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := archive.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		content, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer content.Close()
+		_, err = io.Copy(archive, content)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return archive.Close()
+}
@@ -0,0 +1,93 @@
+//go:build unix
+
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLinkInside(t *testing.T) {
+	parent := t.TempDir()
+	localDir := filepath.Join(parent, "download")
+	for _, dir := range []string{"download/sub", "outside"} {
+		if err := os.MkdirAll(filepath.Join(parent, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Links already on disk, as if created by earlier archive entries or
+	// present in localDir before the download.
+	links := map[string]string{
+		"y":       ".",
+		"escaped": "../outside",
+	}
+	for name, link := range links {
+		if err := os.Symlink(link, filepath.Join(localDir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		target string
+		link   string
+		want   bool
+	}{
+		{"a", "sub", true},
+		{"a", "sub/file", true},
+		{"sub/a", "file", true},
+		{"a", "./sub", true},
+		{"a", "/etc/passwd", false},
+		{"a", "", false},
+		{"a", "..", false},
+		{"a", "../outside", false},
+		{"sub/a", "../file", false},
+		{"a", "sub/../../outside", false},
+		{"x", "y/../escape", false},
+		{"x", "y/sub", false},
+		{"x", "escaped/file", false},
+		{"../a", "file", false},
+	}
+	for _, test := range tests {
+		target := filepath.Join(localDir, test.target)
+		if got := linkInside(localDir, target, test.link); got != test.want {
+			t.Errorf("%s -> %s: got %v, want %v", test.target, test.link, got, test.want)
+		}
+	}
+}
+
+func TestLocalTarget(t *testing.T) {
+	localDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(localDir, "dir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(t.TempDir(), filepath.Join(localDir, "a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("dir", filepath.Join(localDir, "inner")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"file", false},
+		{"dir/file", false},
+		{"new/dir/file", false},
+		{"a", false},
+		{"a/.bashrc", true},
+		{"a/deeper/file", true},
+		{"inner/file", true},
+	}
+	for _, test := range tests {
+		target, err := localTarget(localDir, filepath.FromSlash(test.name))
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: got error %v, want error %v", test.name, err, test.wantErr)
+			continue
+		}
+		if err == nil && target != filepath.Join(localDir, test.name) {
+			t.Errorf("%s: got %s", test.name, target)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+)
+
+type HealthInfo struct {
+	Name   string
+	Config *container.HealthConfig
+	State  *types.Health
+}
+
+func (dc *DockerWrapper) GetContainerHealth(id string) (*HealthInfo, error) {
+	containerInfo, err := dc.api().ContainerInspect(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &HealthInfo{Name: containerInfo.Name[1:], State: containerInfo.State.Health}
+	if containerInfo.Config != nil {
+		health.Config = containerInfo.Config.Healthcheck
+	}
+	return health, nil
+}
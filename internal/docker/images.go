@@ -0,0 +1,33 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/image"
+)
+
+func (dc *DockerWrapper) GetImageAttributes(id string) string {
+	imageInfo, _, _ := dc.api().ImageInspectWithRaw(context.Background(), id)
+	infoJSON, _ := json.MarshalIndent(imageInfo, "", "  ")
+	return string(infoJSON)
+}
+
+func (dc *DockerWrapper) GetImageContainers(imageID string) []types.Container {
+	var containers []types.Container
+	for _, container := range dc.GetContainers(true) {
+		if container.ImageID == imageID {
+			containers = append(containers, container)
+		}
+	}
+	return containers
+}
+
+func (dc *DockerWrapper) RemoveImage(id string, force bool) error {
+	_, err := dc.api().ImageRemove(context.Background(), id, image.RemoveOptions{
+		Force:         force,
+		PruneChildren: true,
+	})
+	return err
+}
@@ -0,0 +1,57 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/docker/docker/api/types/network"
+)
+
+// GetNetworks lists the networks. The list leaves out the containers attached
+// to them, which takes inspecting the network.
+func (dc *DockerWrapper) GetNetworks() []network.Summary {
+	networks, err := dc.api().NetworkList(context.Background(), network.ListOptions{})
+	if err != nil {
+		log.Printf("Error listing networks: %v", err)
+		return nil
+	}
+	return networks
+}
+
+func (dc *DockerWrapper) InspectNetwork(id string) (network.Inspect, error) {
+	return dc.api().NetworkInspect(context.Background(), id, network.InspectOptions{})
+}
+
+func (dc *DockerWrapper) GetNetworkAttributes(id string) string {
+	networkInfo, _ := dc.InspectNetwork(id)
+	infoJSON, _ := json.MarshalIndent(networkInfo, "", "  ")
+	return string(infoJSON)
+}
+
+func (dc *DockerWrapper) CreateNetwork(name, driver, subnet, gateway string) (string, error) {
+	options := network.CreateOptions{Driver: driver}
+	if subnet != "" {
+		options.IPAM = &network.IPAM{
+			Config: []network.IPAMConfig{{Subnet: subnet, Gateway: gateway}},
+		}
+	}
+
+	resp, err := dc.api().NetworkCreate(context.Background(), name, options)
+	if err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+func (dc *DockerWrapper) RemoveNetwork(id string) error {
+	return dc.api().NetworkRemove(context.Background(), id)
+}
+
+func (dc *DockerWrapper) ConnectContainer(networkID, containerID string) error {
+	return dc.api().NetworkConnect(context.Background(), networkID, containerID, nil)
+}
+
+func (dc *DockerWrapper) DisconnectContainer(networkID, containerID string) error {
+	return dc.api().NetworkDisconnect(context.Background(), networkID, containerID, false)
+}
@@ -0,0 +1,44 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/docker/go-connections/nat"
+)
+
+type PortInfo struct {
+	Name string
+	// Networks maps each network the container is attached to to its IP
+	// address on that network.
+	Networks map[string]string
+	// Ports holds every exposed or published port of the container, with the
+	// host bindings of published ports.
+	Ports nat.PortMap
+}
+
+func (dc *DockerWrapper) GetContainerPorts(id string) (*PortInfo, error) {
+	containerInfo, err := dc.api().ContainerInspect(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	ports := &PortInfo{
+		Name:     containerInfo.Name[1:],
+		Networks: make(map[string]string),
+		Ports:    make(nat.PortMap),
+	}
+	if containerInfo.Config != nil {
+		for port := range containerInfo.Config.ExposedPorts {
+			ports.Ports[port] = nil
+		}
+	}
+	if settings := containerInfo.NetworkSettings; settings != nil {
+		for port, bindings := range settings.Ports {
+			ports.Ports[port] = bindings
+		}
+		for name, endpoint := range settings.Networks {
+			ports.Networks[name] = endpoint.IPAddress
+		}
+	}
+	return ports, nil
+}
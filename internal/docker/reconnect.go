@@ -0,0 +1,100 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	connectionCheckInterval = 5 * time.Second
+	initialBackoff          = time.Second
+	maxBackoff              = 30 * time.Second
+)
+
+type ConnectionStatus int
+
+const (
+	StatusConnected ConnectionStatus = iota
+	StatusReconnecting
+)
+
+// ConnectionState is the health of the connection to the daemon. Err and
+// Retry tell why the daemon could not be reached and when the next attempt
+// is made.
+type ConnectionState struct {
+	Status ConnectionStatus
+	Err    error
+	Retry  time.Duration
+}
+
+func (dc *DockerWrapper) ConnectionState() ConnectionState {
+	dc.stateMu.Lock()
+	defer dc.stateMu.Unlock()
+
+	return dc.state
+}
+
+func (dc *DockerWrapper) setConnectionState(state ConnectionState) {
+	dc.stateMu.Lock()
+	defer dc.stateMu.Unlock()
+
+	dc.state = state
+}
+
+// WatchConnection pings the daemon until ctx is done and calls onChange
+// whenever the connection is lost, a reconnect attempt fails or the daemon
+// can be reached again. Failed attempts are retried with exponential backoff.
+func (dc *DockerWrapper) WatchConnection(ctx context.Context, onChange func(ConnectionState)) {
+	backoff := initialBackoff
+	wait := time.Duration(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		err := dc.Ping()
+		previous := dc.ConnectionState()
+		if err == nil {
+			backoff = initialBackoff
+			wait = connectionCheckInterval
+			if previous.Status != StatusConnected {
+				log.Printf("Reconnected to %s", dc.Endpoint().Name)
+				dc.setConnectionState(ConnectionState{Status: StatusConnected})
+				onChange(dc.ConnectionState())
+			}
+			continue
+		}
+
+		log.Printf("Docker daemon %s unreachable, retrying in %s: %v", dc.Endpoint().Name, backoff, err)
+		dc.setConnectionState(ConnectionState{Status: StatusReconnecting, Err: err, Retry: backoff})
+		onChange(dc.ConnectionState())
+		wait = backoff
+		backoff = nextBackoff(backoff)
+	}
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	return min(backoff*2, maxBackoff)
+}
+
+// waitBackoff waits before the next reconnect attempt and reports false when
+// ctx is done first.
+func waitBackoff(ctx context.Context, backoff time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(backoff):
+		return true
+	}
+}
+
+// formatSince formats a time for the since option of the events and logs
+// endpoints, which accept Unix timestamps with nanoseconds.
+func formatSince(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
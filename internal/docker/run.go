@@ -0,0 +1,131 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/go-connections/nat"
+)
+
+// RunOptions describes a new container, using the same notation as the
+// matching docker run flags.
+type RunOptions struct {
+	Name    string
+	Image   string
+	Cmd     []string
+	Env     []string
+	Ports   []string
+	Binds   []string
+	Network string
+	Restart string
+	// NanoCPUs and Memory are zero when unlimited.
+	NanoCPUs int64
+	Memory   int64
+}
+
+// ParseRestartPolicy parses a restart policy in the notation of docker run,
+// e.g. "unless-stopped" or "on-failure:3".
+func ParseRestartPolicy(policy string) (container.RestartPolicy, error) {
+	if policy == "" {
+		return container.RestartPolicy{Name: container.RestartPolicyDisabled}, nil
+	}
+
+	name, retries, hasRetries := strings.Cut(policy, ":")
+	restartPolicy := container.RestartPolicy{Name: container.RestartPolicyMode(name)}
+	if hasRetries {
+		count, err := strconv.Atoi(retries)
+		if err != nil {
+			return restartPolicy, fmt.Errorf("invalid restart policy: maximum retry count %q is not a number", retries)
+		}
+		restartPolicy.MaximumRetryCount = count
+	}
+	return restartPolicy, container.ValidateRestartPolicy(restartPolicy)
+}
+
+// RunContainer creates and starts a container and returns its ID. Like
+// docker run, it pulls the image when it is not on the daemon yet, calling
+// onPull before it does.
+func (dc *DockerWrapper) RunContainer(options RunOptions, onPull func()) (string, error) {
+	exposedPorts, portBindings, err := nat.ParsePortSpecs(options.Ports)
+	if err != nil {
+		return "", err
+	}
+	restartPolicy, err := ParseRestartPolicy(options.Restart)
+	if err != nil {
+		return "", err
+	}
+
+	config := &container.Config{
+		Image:        options.Image,
+		Cmd:          options.Cmd,
+		Env:          options.Env,
+		ExposedPorts: exposedPorts,
+	}
+	hostConfig := &container.HostConfig{
+		Binds:         options.Binds,
+		PortBindings:  portBindings,
+		NetworkMode:   container.NetworkMode(options.Network),
+		RestartPolicy: restartPolicy,
+		Resources: container.Resources{
+			NanoCPUs: options.NanoCPUs,
+			Memory:   options.Memory,
+		},
+	}
+
+	ctx := context.Background()
+	created, err := dc.api().ContainerCreate(ctx, config, hostConfig, nil, nil, options.Name)
+	if errdefs.IsNotFound(err) && !dc.hasImage(ctx, options.Image) {
+		if onPull != nil {
+			onPull()
+		}
+		if err := dc.pullImage(ctx, options.Image); err != nil {
+			return "", fmt.Errorf("pulling %s: %w", options.Image, err)
+		}
+		created, err = dc.api().ContainerCreate(ctx, config, hostConfig, nil, nil, options.Name)
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := dc.api().ContainerStart(ctx, created.ID, container.StartOptions{}); err != nil {
+		return created.ID, err
+	}
+	return created.ID, nil
+}
+
+func (dc *DockerWrapper) hasImage(ctx context.Context, ref string) bool {
+	_, _, err := dc.api().ImageInspectWithRaw(ctx, ref)
+	return !errdefs.IsNotFound(err)
+}
+
+// pullImage pulls an image and waits until the pull is done. Errors of the
+// pull are only reported in its progress stream.
+func (dc *DockerWrapper) pullImage(ctx context.Context, ref string) error {
+	progress, err := dc.api().ImagePull(ctx, ref, image.PullOptions{})
+	if err != nil {
+		return err
+	}
+	defer progress.Close()
+
+	messages := json.NewDecoder(progress)
+	for {
+		var message struct {
+			Error string `json:"error"`
+		}
+		if err := messages.Decode(&message); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if message.Error != "" {
+			return errors.New(message.Error)
+		}
+	}
+}
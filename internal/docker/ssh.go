@@ -0,0 +1,212 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/client"
+)
+
+// newSSHClient creates a client for an ssh://[user@]host[:port][/socket]
+// endpoint. Every connection runs docker system dial-stdio on the remote host
+// through the ssh binary, so the ssh config, known hosts and agent of the
+// user apply. BatchMode keeps ssh from prompting for passwords, which would
+// break the terminal UI.
+func newSSHClient(host string) (*client.Client, error) {
+	args, err := sshArgs(host)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCommandClient("ssh", args...)
+}
+
+// newCommandClient creates a client whose connections run a command that
+// speaks the Docker API on its stdin and stdout, like docker system
+// dial-stdio.
+func newCommandClient(name string, args ...string) (*client.Client, error) {
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return dialCommand(ctx, name, args...)
+	}
+	return client.NewClientWithOpts(
+		client.WithAPIVersionNegotiation(),
+		// The host is only used for the HTTP requests, connections are
+		// made by the dialer.
+		client.WithHost("http://docker.example.com"),
+		client.WithDialContext(dial),
+	)
+}
+
+func isSSHHost(host string) bool {
+	return strings.HasPrefix(host, "ssh://")
+}
+
+func sshArgs(host string) ([]string, error) {
+	sshURL, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	if sshURL.Hostname() == "" {
+		return nil, fmt.Errorf("invalid ssh host %q: no hostname", host)
+	}
+	if _, hasPassword := sshURL.User.Password(); hasPassword {
+		return nil, fmt.Errorf("invalid ssh host %q: passwords are not supported, use keys or the ssh agent", host)
+	}
+
+	args := []string{"-o", "BatchMode=yes", "-o", "ConnectTimeout=10", "-T"}
+	if port := sshURL.Port(); port != "" {
+		args = append(args, "-p", port)
+	}
+	if user := sshURL.User.Username(); user != "" {
+		args = append(args, "-l", user)
+	}
+	args = append(args, "--", sshURL.Hostname(), "docker")
+	if socket := strings.TrimSuffix(sshURL.Path, "/"); socket != "" {
+		args = append(args, "-H", "unix://"+socket)
+	}
+	return append(args, "system", "dial-stdio"), nil
+}
+
+// commandConn is a connection over the stdin and stdout of a command. The
+// pipes are created with os.Pipe, which supports deadlines.
+type commandConn struct {
+	cmd       *exec.Cmd
+	stdin     *os.File
+	stdout    *os.File
+	stderr    *syncBuffer
+	connected chan struct{}
+	closed    chan struct{}
+
+	connectOnce sync.Once
+	closeOnce   sync.Once
+}
+
+func dialCommand(ctx context.Context, name string, args ...string) (net.Conn, error) {
+	stdinReader, stdin, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		stdinReader.Close()
+		stdin.Close()
+		return nil, err
+	}
+	stderr := &syncBuffer{}
+
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = stdinReader
+	cmd.Stdout = stdoutWriter
+	cmd.Stderr = stderr
+	err = cmd.Start()
+	// The command has its own copies of these ends of the pipes.
+	stdinReader.Close()
+	stdoutWriter.Close()
+	if err != nil {
+		stdin.Close()
+		stdout.Close()
+		return nil, fmt.Errorf("starting %s failed: %w", name, err)
+	}
+
+	conn := &commandConn{
+		cmd:       cmd,
+		stdin:     stdin,
+		stdout:    stdout,
+		stderr:    stderr,
+		connected: make(chan struct{}),
+		closed:    make(chan struct{}),
+	}
+	go conn.closeOnCancel(ctx)
+	return conn, nil
+}
+
+// closeOnCancel ends the command when ctx is done before the command sent
+// anything, e.g. when connecting times out. Once the connection is in use,
+// the HTTP transport closes it.
+func (c *commandConn) closeOnCancel(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+		c.Close()
+	case <-c.connected:
+	case <-c.closed:
+	}
+}
+
+// Read returns the error output of the command when it exits, e.g. the
+// reason an ssh connection failed.
+func (c *commandConn) Read(p []byte) (int, error) {
+	n, err := c.stdout.Read(p)
+	if n > 0 {
+		c.connectOnce.Do(func() {
+			close(c.connected)
+		})
+	}
+	if errors.Is(err, io.EOF) {
+		if message := strings.TrimSpace(c.stderr.String()); message != "" {
+			return n, fmt.Errorf("%w: %s", err, message)
+		}
+	}
+	return n, err
+}
+
+func (c *commandConn) Write(p []byte) (int, error) {
+	return c.stdin.Write(p)
+}
+
+func (c *commandConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.stdin.Close()
+		c.cmd.Process.Kill()
+		c.cmd.Wait()
+		c.stdout.Close()
+	})
+	return nil
+}
+
+func (c *commandConn) LocalAddr() net.Addr  { return commandAddr{} }
+func (c *commandConn) RemoteAddr() net.Addr { return commandAddr{} }
+
+func (c *commandConn) SetDeadline(t time.Time) error {
+	return errors.Join(c.SetReadDeadline(t), c.SetWriteDeadline(t))
+}
+
+func (c *commandConn) SetReadDeadline(t time.Time) error {
+	return c.stdout.SetReadDeadline(t)
+}
+
+func (c *commandConn) SetWriteDeadline(t time.Time) error {
+	return c.stdin.SetWriteDeadline(t)
+}
+
+type commandAddr struct{}
+
+func (commandAddr) Network() string { return "command" }
+func (commandAddr) String() string  { return "command" }
+
+type syncBuffer struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.String()
+}
@@ -0,0 +1,49 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+
+	"github.com/docker/docker/api/types"
+)
+
+type ContainerStats struct {
+	ID          string
+	CPUUsage    float64
+	MemoryUsage float64
+}
+
+// StreamContainerStats sends a ContainerStats for every sample the daemon
+// streams for the container, until ctx is cancelled or the stream ends.
+func (dc *DockerWrapper) StreamContainerStats(ctx context.Context, id string, statsChan chan<- ContainerStats) {
+	stats, err := dc.api().ContainerStats(ctx, id, true)
+	if err != nil {
+		log.Printf("Error streaming stats for %s: %v", id, err)
+		return
+	}
+	defer stats.Body.Close()
+
+	decoder := json.NewDecoder(stats.Body)
+	for {
+		var statsJSON types.StatsJSON
+		if err := decoder.Decode(&statsJSON); err != nil {
+			if ctx.Err() == nil && !errors.Is(err, io.EOF) {
+				log.Printf("Error decoding stats for %s: %v", id, err)
+			}
+			return
+		}
+
+		select {
+		case statsChan <- ContainerStats{
+			ID:          id,
+			CPUUsage:    calculateCPUUsage(&statsJSON),
+			MemoryUsage: calculateMemoryUsageMb(&statsJSON),
+		}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package docker
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/versions"
+)
+
+type DiskUsageSummary struct {
+	Type        string
+	Total       int
+	Active      int
+	Size        int64
+	Reclaimable int64
+}
+
+type PruneReport struct {
+	Deleted        int
+	SpaceReclaimed uint64
+}
+
+// GetDiskUsage returns the disk usage of images, containers, volumes and the
+// build cache, computed the same way as docker system df.
+func (dc *DockerWrapper) GetDiskUsage() ([]DiskUsageSummary, error) {
+	usage, err := dc.api().DiskUsage(context.Background(), types.DiskUsageOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	images := DiskUsageSummary{Type: "Images", Total: len(usage.Images), Size: usage.LayersSize}
+	var usedImageSize int64
+	for _, image := range usage.Images {
+		if image.Containers > 0 {
+			images.Active++
+			if image.Size != -1 && image.SharedSize != -1 {
+				usedImageSize += image.Size - image.SharedSize
+			}
+		}
+	}
+	images.Reclaimable = max(images.Size-usedImageSize, 0)
+
+	containers := DiskUsageSummary{Type: "Containers", Total: len(usage.Containers)}
+	for _, container := range usage.Containers {
+		containers.Size += container.SizeRw
+		if container.State == "running" {
+			containers.Active++
+		} else {
+			containers.Reclaimable += container.SizeRw
+		}
+	}
+
+	volumes := DiskUsageSummary{Type: "Volumes", Total: len(usage.Volumes)}
+	for _, vol := range usage.Volumes {
+		if vol.UsageData == nil || vol.UsageData.Size == -1 {
+			continue
+		}
+		volumes.Size += vol.UsageData.Size
+		if vol.UsageData.RefCount > 0 {
+			volumes.Active++
+		} else {
+			volumes.Reclaimable += vol.UsageData.Size
+		}
+	}
+
+	buildCache := DiskUsageSummary{Type: "Build cache", Total: len(usage.BuildCache)}
+	for _, cache := range usage.BuildCache {
+		if cache.InUse {
+			buildCache.Active++
+		}
+		if cache.Shared {
+			continue
+		}
+		buildCache.Size += cache.Size
+		if !cache.InUse {
+			buildCache.Reclaimable += cache.Size
+		}
+	}
+
+	return []DiskUsageSummary{images, containers, volumes, buildCache}, nil
+}
+
+func (dc *DockerWrapper) PruneContainers() (PruneReport, error) {
+	report, err := dc.api().ContainersPrune(context.Background(), filters.NewArgs())
+	return PruneReport{Deleted: len(report.ContainersDeleted), SpaceReclaimed: report.SpaceReclaimed}, err
+}
+
+// PruneImages removes dangling images, or every image without containers
+// when all is set.
+func (dc *DockerWrapper) PruneImages(all bool) (PruneReport, error) {
+	pruneFilters := filters.NewArgs()
+	if all {
+		pruneFilters.Add("dangling", "false")
+	}
+	report, err := dc.api().ImagesPrune(context.Background(), pruneFilters)
+	return PruneReport{Deleted: len(report.ImagesDeleted), SpaceReclaimed: report.SpaceReclaimed}, err
+}
+
+// PruneVolumes removes every volume that is not used by a container,
+// including named volumes. Daemons before API 1.42 do not know the all filter
+// and always prune named volumes, so it is left out for them.
+func (dc *DockerWrapper) PruneVolumes() (PruneReport, error) {
+	ctx := context.Background()
+	apiClient := dc.api()
+	apiClient.NegotiateAPIVersion(ctx)
+
+	pruneFilters := filters.NewArgs()
+	if !versions.LessThan(apiClient.ClientVersion(), "1.42") {
+		pruneFilters.Add("all", "true")
+	}
+	report, err := apiClient.VolumesPrune(ctx, pruneFilters)
+	return PruneReport{Deleted: len(report.VolumesDeleted), SpaceReclaimed: report.SpaceReclaimed}, err
+}
+
+func (dc *DockerWrapper) PruneBuildCache() (PruneReport, error) {
+	report, err := dc.api().BuildCachePrune(context.Background(), types.BuildCachePruneOptions{All: true})
+	if err != nil {
+		return PruneReport{}, err
+	}
+	return PruneReport{Deleted: len(report.CachesDeleted), SpaceReclaimed: report.SpaceReclaimed}, nil
+}
@@ -0,0 +1,222 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Process struct {
+	HostPID string
+	User    string
+	CPU     float64
+	Memory  float64
+	Command string
+	// Elapsed is the number of seconds since the process started.
+	Elapsed int
+	// Container is the process as seen in the container. Its PID is empty
+	// when the process could not be told apart from the others.
+	Container ContainerProcess
+}
+
+// ContainerProcess is a process as seen in the PID namespace of its container.
+// StartTime is in clock ticks since boot and tells the process apart from a
+// later one reusing its PID.
+type ContainerProcess struct {
+	PID       string
+	StartTime string
+}
+
+// listProcessesScript prints the uptime, followed by the PID, start time,
+// clock ticks per second and command line of every process in the PID
+// namespace of the container.
+const listProcessesScript = `hz=$(getconf CLK_TCK 2>/dev/null || echo 100)
+cat /proc/uptime
+for d in /proc/[0-9]*; do
+	read -r stat 2>/dev/null < "$d/stat" || continue
+	set -- ${stat##*) }
+	printf '%s\t%s\t%s\t' "${d#/proc/}" "${20}" "$hz"
+	cat "$d/cmdline"
+	echo
+done`
+
+// signalProcessScript sends a signal to a process, unless the PID now belongs
+// to a process with another start time.
+const signalProcessScript = `signal=$1 pid=$2 started=$3
+read -r stat 2>/dev/null < "/proc/$pid/stat" || { echo "process $pid has exited" >&2; exit 1; }
+set -- ${stat##*) }
+[ "${20}" = "$started" ] || { echo "process $pid has exited" >&2; exit 1; }
+kill -s "$signal" "$pid"`
+
+// GetProcesses lists the processes of a container. The daemon only reports
+// host PIDs and the PID namespace hides them from the container, so the PIDs
+// in the container are looked up by command line and start time, with one
+// exec in the container. Containers without a shell only get host PIDs.
+func (dc *DockerWrapper) GetProcesses(id string) ([]Process, error) {
+	started := time.Now()
+	top, err := dc.api().ContainerTop(context.Background(), id, []string{"-o", "pid,user,pcpu,pmem,etimes,args"})
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, title := range top.Titles {
+		columns[title] = i
+	}
+	column := func(row []string, title string) string {
+		if i, exists := columns[title]; exists && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+
+	processes := make([]Process, 0, len(top.Processes))
+	for _, row := range top.Processes {
+		cpu, _ := strconv.ParseFloat(column(row, "%CPU"), 64)
+		memory, _ := strconv.ParseFloat(column(row, "%MEM"), 64)
+		elapsed, _ := strconv.Atoi(column(row, "ELAPSED"))
+		processes = append(processes, Process{
+			HostPID: column(row, "PID"),
+			User:    column(row, "USER"),
+			CPU:     cpu,
+			Memory:  memory,
+			Command: column(row, "COMMAND"),
+			Elapsed: elapsed,
+		})
+	}
+
+	output, err := dc.runCommand(id, []string{"sh", "-c", listProcessesScript})
+	if err != nil {
+		log.Printf("Error listing processes in container %s: %v", id, err)
+		return processes, nil
+	}
+	candidates, err := parseContainerProcesses(output)
+	if err != nil {
+		log.Printf("Error listing processes in container %s: %v", id, err)
+		return processes, nil
+	}
+	matchContainerProcesses(processes, candidates, time.Since(started).Seconds())
+	return processes, nil
+}
+
+type containerProcessEntry struct {
+	ContainerProcess
+	command string
+	elapsed float64
+}
+
+func parseContainerProcesses(output string) ([]containerProcessEntry, error) {
+	uptimeLine, rest, _ := strings.Cut(output, "\n")
+	uptimeFields := strings.Fields(uptimeLine)
+	if len(uptimeFields) == 0 {
+		return nil, fmt.Errorf("unexpected process listing %q", uptimeLine)
+	}
+	uptime, err := strconv.ParseFloat(uptimeFields[0], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []containerProcessEntry
+	for _, line := range strings.Split(rest, "\n") {
+		fields := strings.SplitN(line, "\t", 4)
+		if len(fields) != 4 {
+			continue
+		}
+		startTicks, errStart := strconv.ParseFloat(fields[1], 64)
+		ticks, errTicks := strconv.ParseFloat(fields[2], 64)
+		if errStart != nil || errTicks != nil || ticks == 0 {
+			continue
+		}
+		entries = append(entries, containerProcessEntry{
+			ContainerProcess: ContainerProcess{PID: fields[0], StartTime: fields[1]},
+			command:          strings.TrimSpace(strings.ReplaceAll(fields[3], "\x00", " ")),
+			elapsed:          uptime - startTicks/ticks,
+		})
+	}
+	return entries, nil
+}
+
+// matchContainerProcesses sets the process in the container of every host
+// process with the same command line and start time. lag is the number of
+// seconds between the host listing and the listing in the container.
+//
+// Processes with the same command line started in the same second, like the
+// workers of a server, are paired in PID order, as both namespaces hand out
+// PIDs as the processes are forked. Processes that still can not be told
+// apart are left without a PID in the container.
+func matchContainerProcesses(processes []Process, candidates []containerProcessEntry, lag float64) {
+	// The elapsed time from ps is truncated to seconds and was taken up to
+	// lag seconds before the listing in the container.
+	within := func(process Process, candidate containerProcessEntry) bool {
+		return candidate.elapsed >= float64(process.Elapsed)-0.5 &&
+			candidate.elapsed <= float64(process.Elapsed)+1.5+lag
+	}
+
+	hostByCommand := make(map[string][]int)
+	for i := range processes {
+		command := strings.TrimSpace(processes[i].Command)
+		hostByCommand[command] = append(hostByCommand[command], i)
+	}
+	containerByCommand := make(map[string][]containerProcessEntry)
+	for _, candidate := range candidates {
+		containerByCommand[candidate.command] = append(containerByCommand[candidate.command], candidate)
+	}
+
+	for command, hosts := range hostByCommand {
+		entries := containerByCommand[command]
+		sort.Slice(hosts, func(i, j int) bool {
+			return pidLess(processes[hosts[i]].HostPID, processes[hosts[j]].HostPID)
+		})
+		sort.Slice(entries, func(i, j int) bool {
+			return pidLess(entries[i].PID, entries[j].PID)
+		})
+
+		if len(hosts) == len(entries) {
+			paired := true
+			for i, host := range hosts {
+				paired = paired && within(processes[host], entries[i])
+			}
+			if paired {
+				for i, host := range hosts {
+					processes[host].Container = entries[i].ContainerProcess
+				}
+				continue
+			}
+		}
+
+		// Pair only processes that match exactly one process the other way
+		// around as well.
+		matches := make(map[int][]int)
+		matchedBy := make(map[int]int)
+		for _, host := range hosts {
+			for j, entry := range entries {
+				if within(processes[host], entry) {
+					matches[host] = append(matches[host], j)
+					matchedBy[j]++
+				}
+			}
+		}
+		for _, host := range hosts {
+			if found := matches[host]; len(found) == 1 && matchedBy[found[0]] == 1 {
+				processes[host].Container = entries[found[0]].ContainerProcess
+			}
+		}
+	}
+}
+
+func pidLess(a, b string) bool {
+	pidA, _ := strconv.Atoi(a)
+	pidB, _ := strconv.Atoi(b)
+	return pidA < pidB
+}
+
+// SignalProcess sends a signal to a process in a container, using the kill
+// builtin of the shell in the container.
+func (dc *DockerWrapper) SignalProcess(id string, process ContainerProcess, signal string) error {
+	_, err := dc.runCommand(id, []string{"sh", "-c", signalProcessScript, "sh", strings.TrimPrefix(signal, "SIG"), process.PID, process.StartTime})
+	return err
+}
@@ -0,0 +1,42 @@
+package docker
+
+import "testing"
+
+func TestMatchContainerProcesses(t *testing.T) {
+	processes := []Process{
+		{HostPID: "4100", Command: "nginx: master process", Elapsed: 60},
+		{HostPID: "4152", Command: "nginx: worker process", Elapsed: 59},
+		{HostPID: "4151", Command: "nginx: worker process", Elapsed: 59},
+		{HostPID: "4300", Command: "sleep 10", Elapsed: 5},
+		{HostPID: "4301", Command: "sleep 10", Elapsed: 5},
+		{HostPID: "4400", Command: "cron", Elapsed: 30},
+	}
+	candidates := []containerProcessEntry{
+		{ContainerProcess{PID: "1", StartTime: "100"}, "nginx: master process", 60.2},
+		{ContainerProcess{PID: "7", StartTime: "110"}, "nginx: worker process", 59.4},
+		{ContainerProcess{PID: "8", StartTime: "111"}, "nginx: worker process", 59.3},
+		// Only one of the sleeps is still running in the container.
+		{ContainerProcess{PID: "20", StartTime: "500"}, "sleep 10", 5.1},
+		{ContainerProcess{PID: "30", StartTime: "900"}, "cron", 2},
+		{ContainerProcess{PID: "31", StartTime: "901"}, "sh -c list", 0},
+	}
+
+	matchContainerProcesses(processes, candidates, 0.2)
+
+	want := map[string]string{
+		"4100": "1",
+		"4151": "7",
+		"4152": "8",
+		"4300": "",
+		"4301": "",
+		"4400": "",
+	}
+	for _, process := range processes {
+		if process.Container.PID != want[process.HostPID] {
+			t.Errorf("host process %s: got PID %q, want %q", process.HostPID, process.Container.PID, want[process.HostPID])
+		}
+	}
+	if processes[0].Container.StartTime != "100" {
+		t.Errorf("got start time %q, want 100", processes[0].Container.StartTime)
+	}
+}
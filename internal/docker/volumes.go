@@ -0,0 +1,62 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+)
+
+func (dc *DockerWrapper) GetVolumeUsage() map[string]*volume.UsageData {
+	usage := make(map[string]*volume.UsageData)
+
+	diskUsage, err := dc.api().DiskUsage(context.Background(), types.DiskUsageOptions{
+		Types: []types.DiskUsageObject{types.VolumeObject},
+	})
+	if err != nil {
+		log.Printf("Error fetching volume disk usage: %v", err)
+		return usage
+	}
+
+	for _, vol := range diskUsage.Volumes {
+		usage[vol.Name] = vol.UsageData
+	}
+	return usage
+}
+
+func (dc *DockerWrapper) GetVolumeContainers() map[string][]types.Container {
+	volumeContainers := make(map[string][]types.Container)
+	for _, container := range dc.GetContainers(true) {
+		for _, mountPoint := range container.Mounts {
+			if mountPoint.Type == mount.TypeVolume {
+				volumeContainers[mountPoint.Name] = append(volumeContainers[mountPoint.Name], container)
+			}
+		}
+	}
+	return volumeContainers
+}
+
+func (dc *DockerWrapper) GetVolumeAttributes(name string) string {
+	volumeInfo, _, _ := dc.api().VolumeInspectWithRaw(context.Background(), name)
+	infoJSON, _ := json.MarshalIndent(volumeInfo, "", "  ")
+	return string(infoJSON)
+}
+
+func (dc *DockerWrapper) CreateVolume(name, driver string, labels map[string]string) (string, error) {
+	vol, err := dc.api().VolumeCreate(context.Background(), volume.CreateOptions{
+		Name:   name,
+		Driver: driver,
+		Labels: labels,
+	})
+	if err != nil {
+		return "", err
+	}
+	return vol.Name, nil
+}
+
+func (dc *DockerWrapper) RemoveVolume(name string, force bool) error {
+	return dc.api().VolumeRemove(context.Background(), name, force)
+}
@@ -0,0 +1,194 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"main/internal/docker"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// markedContainers holds the IDs of containers marked for bulk actions.
+var markedContainers = make(map[string]bool)
+
+func toggleMark(table *tview.Table) {
+	row, _ := table.GetSelection()
+	containerID, ok := table.GetCell(row, 0).GetReference().(string)
+	if !ok {
+		return
+	}
+
+	if markedContainers[containerID] {
+		delete(markedContainers, containerID)
+	} else {
+		markedContainers[containerID] = true
+	}
+	applyMarkStyle(table, row)
+
+	if row+1 < table.GetRowCount() {
+		table.Select(row+1, 0)
+	}
+}
+
+// toggleMarkAll marks every visible container, or clears all marks when every
+// visible container is already marked.
+func toggleMarkAll(table *tview.Table) {
+	ids := visibleContainerIDs(table)
+
+	allMarked := true
+	for _, id := range ids {
+		if !markedContainers[id] {
+			allMarked = false
+			break
+		}
+	}
+
+	for _, id := range ids {
+		if allMarked {
+			delete(markedContainers, id)
+		} else {
+			markedContainers[id] = true
+		}
+	}
+	applyMarkStyles(table)
+}
+
+func showMarkByFilterForm(table *tview.Table) {
+	form := tview.NewForm().
+		AddInputField("Pattern", "", 40, nil, nil)
+
+	form.AddButton("Mark", func() {
+		pattern := strings.ToLower(form.GetFormItemByLabel("Pattern").(*tview.InputField).GetText())
+		closeModal()
+
+		marked := 0
+		for row := 1; row < table.GetRowCount(); row++ {
+			containerID, ok := table.GetCell(row, 0).GetReference().(string)
+			if !ok {
+				continue
+			}
+			name := strings.ToLower(table.GetCell(row, 1).Text)
+			image := strings.ToLower(table.GetCell(row, 2).Text)
+			if strings.Contains(name, pattern) || strings.Contains(image, pattern) {
+				markedContainers[containerID] = true
+				marked++
+			}
+		}
+		applyMarkStyles(table)
+		NotificationInfo(fmt.Sprintf("Marked %d containers matching %q", marked, pattern))
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Mark containers", form, 60, 7)
+}
+
+func clearMarks(table *tview.Table) {
+	markedContainers = make(map[string]bool)
+	applyMarkStyles(table)
+}
+
+func applyMarkStyles(table *tview.Table) {
+	for row := 1; row < table.GetRowCount(); row++ {
+		applyMarkStyle(table, row)
+	}
+}
+
+func applyMarkStyle(table *tview.Table, row int) {
+	containerID, ok := table.GetCell(row, 0).GetReference().(string)
+	if !ok {
+		return
+	}
+
+	color := tview.Styles.PrimaryTextColor
+	if markedContainers[containerID] {
+		color = tcell.ColorOrange
+	}
+	for column := 0; column < table.GetColumnCount(); column++ {
+		table.GetCell(row, column).SetTextColor(color)
+	}
+}
+
+func visibleContainerIDs(table *tview.Table) []string {
+	var ids []string
+	for row := 1; row < table.GetRowCount(); row++ {
+		if containerID, ok := table.GetCell(row, 0).GetReference().(string); ok {
+			ids = append(ids, containerID)
+		}
+	}
+	return ids
+}
+
+// targetContainers returns the marked containers, or the selected container
+// when nothing is marked.
+func targetContainers(table *tview.Table) []string {
+	var ids []string
+	for _, id := range visibleContainerIDs(table) {
+		if markedContainers[id] {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > 0 {
+		return ids
+	}
+
+	if containerID := selectedReference(table); containerID != "" {
+		return []string{containerID}
+	}
+	return nil
+}
+
+// showBulkConfirmation asks for confirmation once and then runs the action for
+// every target container, reporting which containers succeeded and failed.
+func showBulkConfirmation(table *tview.Table, action, message, verb string, run func(client *docker.DockerWrapper, id string) error) {
+	ids := targetContainers(table)
+	if len(ids) == 0 {
+		return
+	}
+	names := make(map[string]string)
+	for _, id := range ids {
+		names[id] = containerModel.name(id)
+	}
+
+	subject, height := names[ids[0]], 10
+	if len(ids) > 1 {
+		var listed []string
+		for _, id := range ids {
+			listed = append(listed, names[id])
+		}
+		subject = fmt.Sprintf("%d containers", len(ids))
+		message = strings.TrimSpace(message + "\n" + strings.Join(listed, ", "))
+		height = min(10+len(ids)/3, 20)
+	}
+
+	showConfirmationModal(action, subject, message, func() {
+		app.QueueUpdateDraw(func() {
+			clearMarks(table)
+		})
+
+		var succeeded, failed []string
+		for _, id := range ids {
+			if err := run(clientFor(id), id); err != nil {
+				log.Printf("%s %s failed: %v", action, id, err)
+				failed = append(failed, fmt.Sprintf("%s (%v)", names[id], err))
+				continue
+			}
+			succeeded = append(succeeded, names[id])
+		}
+		reportBulkResult(verb, succeeded, failed)
+	}, 60, height)
+}
+
+func reportBulkResult(verb string, succeeded, failed []string) {
+	if len(failed) == 0 {
+		NotificationSuccess(fmt.Sprintf("%s %s", verb, strings.Join(succeeded, ", ")))
+		return
+	}
+
+	message := fmt.Sprintf("%s failed: %s", verb, strings.Join(failed, ", "))
+	if len(succeeded) > 0 {
+		message = fmt.Sprintf("%s %s | %s", verb, strings.Join(succeeded, ", "), message)
+	}
+	NotificationError(fmt.Errorf("%s", message))
+}
@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"main/internal/docker"
+	"sort"
+
+	"github.com/docker/docker/api/types"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// composeProject is stored as the reference of a project header row, to tell
+// it apart from container rows which reference the container ID.
+type composeProject string
+
+type containerGroup struct {
+	project    string
+	containers []types.Container
+}
+
+var (
+	groupByProject    bool
+	collapsedProjects = make(map[string]bool)
+)
+
+// groupContainersByProject groups containers by their compose project label.
+// Containers that are not part of a project are returned last, in a group
+// with an empty project name.
+func groupContainersByProject(containers []types.Container) []containerGroup {
+	projects := make(map[string][]types.Container)
+	for _, container := range containers {
+		project := container.Labels[docker.ComposeProjectLabel]
+		projects[project] = append(projects[project], container)
+	}
+
+	var groups []containerGroup
+	for project, projectContainers := range projects {
+		if project == "" {
+			continue
+		}
+		groups = append(groups, containerGroup{project: project, containers: projectContainers})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].project < groups[j].project
+	})
+
+	if ungrouped, exists := projects[""]; exists {
+		groups = append(groups, containerGroup{containers: ungrouped})
+	}
+	return groups
+}
+
+func setProjectRow(table *tview.Table, row int, group containerGroup) {
+	running := 0
+	for _, container := range group.containers {
+		if container.State == "running" {
+			running++
+		}
+	}
+
+	marker := "▾"
+	if collapsedProjects[group.project] {
+		marker = "▸"
+	}
+
+	state := fmt.Sprintf("%d/%d running", running, len(group.containers))
+	switch running {
+	case len(group.containers):
+		state = fmt.Sprintf("[green:black]%s[white:black]", state)
+	case 0:
+		state = fmt.Sprintf("[gray:black]%s[white:black]", state)
+	default:
+		state = fmt.Sprintf("[yellow:black]%s[white:black]", state)
+	}
+
+	table.SetCell(row, 0, tview.NewTableCell(marker).SetReference(composeProject(group.project)))
+	table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("[::b]%s[::B]", group.project)))
+	table.SetCell(row, 2, tview.NewTableCell("[gray]compose project"))
+	table.SetCell(row, 3, tview.NewTableCell(""))
+	table.SetCell(row, 4, tview.NewTableCell(state))
+	table.SetCell(row, 5, tview.NewTableCell(""))
+	table.SetCell(row, 6, tview.NewTableCell(""))
+	table.SetCell(row, 7, tview.NewTableCell(""))
+	if aggregatedView {
+		table.SetCell(row, 8, tview.NewTableCell(""))
+	}
+}
+
+func selectedProject(table *tview.Table) (string, bool) {
+	row, _ := table.GetSelection()
+	project, ok := table.GetCell(row, 0).GetReference().(composeProject)
+	return string(project), ok
+}
+
+func toggleProject(table *tview.Table, project string) {
+	collapsedProjects[project] = !collapsedProjects[project]
+	updateFilteredContainers(table)
+	selectProjectRow(table, project)
+}
+
+func selectProjectRow(table *tview.Table, project string) {
+	for row := 1; row < table.GetRowCount(); row++ {
+		if reference, ok := table.GetCell(row, 0).GetReference().(composeProject); ok && string(reference) == project {
+			table.Select(row, 0)
+			return
+		}
+	}
+}
+
+func handleProjectInput(event *tcell.EventKey, project string) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyCtrlD:
+		showProjectConfirmation("REMOVE", project, "This will stop and delete all containers in the project!", func(client *docker.DockerWrapper, ids []string) error {
+			client.StopContainers(ids)
+			return errors.Join(client.RemoveContainers(ids)...)
+		})
+	case tcell.KeyCtrlR:
+		showProjectConfirmation("START", project, "", func(client *docker.DockerWrapper, ids []string) error {
+			return errors.Join(client.StartContainers(ids)...)
+		})
+	case tcell.KeyCtrlS:
+		showProjectConfirmation("STOP", project, "", func(client *docker.DockerWrapper, ids []string) error {
+			return errors.Join(client.StopContainers(ids)...)
+		})
+	case tcell.KeyCtrlT:
+		showProjectConfirmation("RESTART", project, "", func(client *docker.DockerWrapper, ids []string) error {
+			return errors.Join(client.RestartContainers(ids)...)
+		})
+	default:
+		return event
+	}
+	return nil
+}
+
+// showProjectConfirmation runs an action for the containers of a project on
+// every daemon the project has containers on.
+func showProjectConfirmation(action, project, message string, run func(client *docker.DockerWrapper, ids []string) error) {
+	hosts := activeHosts()
+
+	showConfirmationModal(action, "project "+project, message, func() {
+		var errs []error
+		total := 0
+		for _, host := range hosts {
+			var ids []string
+			for _, container := range host.client.GetProjectContainers(project) {
+				ids = append(ids, container.ID)
+			}
+			if len(ids) > 0 {
+				errs = append(errs, run(host.client, ids))
+				total += len(ids)
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("%s project %s (%d containers)", action, project, total))
+	}, 60, 10)
+}
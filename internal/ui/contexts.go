@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"fmt"
+	"main/internal/docker"
+
+	"github.com/rivo/tview"
+)
+
+func showContextPicker() {
+	endpoints := docker.LoadEndpoints(userConf.Hosts)
+	current := dockerClient.Endpoint()
+
+	options := make([]string, len(endpoints))
+	selected := 0
+	for i, endpoint := range endpoints {
+		options[i] = fmt.Sprintf("%s - %s (%s)", endpoint.Name, endpoint.Host, endpoint.Source)
+		if endpoint == current {
+			selected = i
+		}
+	}
+
+	form := tview.NewForm().
+		AddDropDown("Endpoint", options, selected, nil)
+
+	form.AddButton("Connect", func() {
+		index, _ := form.GetFormItemByLabel("Endpoint").(*tview.DropDown).GetCurrentOption()
+		closeModal()
+		if index < 0 || endpoints[index] == current {
+			return
+		}
+		switchEndpoint(endpoints[index])
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Switch Docker context", form, 90, 7)
+}
+
+// switchEndpoint connects to another daemon and redraws the home screen, which
+// recreates the event listener and stats monitor for the new client. The
+// current daemon stays active when the new one can not be reached.
+func switchEndpoint(endpoint docker.Endpoint) {
+	closeAggregatedView()
+	cancelEventListener()
+	NotificationInfo(fmt.Sprintf("Connecting to %s (%s)...", endpoint.Name, endpoint.Host))
+
+	go func() {
+		err := dockerClient.Connect(endpoint)
+		app.QueueUpdateDraw(func() {
+			if err == nil {
+				containerModel = newContainerStore()
+				markedContainers = make(map[string]bool)
+				collapsedProjects = make(map[string]bool)
+			}
+			DrawHome()
+			if err != nil {
+				NotificationError(err)
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Connected to %s (%s)", endpoint.Name, endpoint.Host))
+		})
+	}()
+}
@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
+	"github.com/rivo/tview"
+)
+
+// formatContainerDetails renders the inspect output of a container as
+// sections, with the fields that are otherwise buried in the raw JSON.
+func formatContainerDetails(info types.ContainerJSON) string {
+	var sb strings.Builder
+	section := func(title string) {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "[orange::b]%s[white::B]\n", title)
+	}
+	field := func(name string, value interface{}) {
+		fmt.Fprintf(&sb, "  [green]%-20s[white]%v\n", name+":", value)
+	}
+
+	section("General")
+	field("Name", strings.TrimPrefix(info.Name, "/"))
+	field("ID", shortID(info.ID))
+	if info.Config != nil {
+		field("Image", tview.Escape(info.Config.Image))
+	}
+	field("Created", formatTimestamp(info.Created))
+
+	if state := info.State; state != nil {
+		section("State")
+		field("Status", formatStatus(state.Status))
+		field("Started", formatTimestamp(state.StartedAt))
+		field("Finished", formatTimestamp(state.FinishedAt))
+		exitCode := fmt.Sprint(state.ExitCode)
+		if state.ExitCode != 0 {
+			exitCode = fmt.Sprintf("[red]%d[white]", state.ExitCode)
+		}
+		field("Exit code", exitCode)
+		oomKilled := "no"
+		if state.OOMKilled {
+			oomKilled = "[red]yes[white]"
+		}
+		field("OOM killed", oomKilled)
+		field("Restart count", info.RestartCount)
+		if state.Error != "" {
+			field("Error", "[red]"+tview.Escape(state.Error)+"[white]")
+		}
+	}
+
+	section("Command")
+	field("Path", tview.Escape(strings.TrimSpace(info.Path+" "+strings.Join(info.Args, " "))))
+	if config := info.Config; config != nil {
+		field("Entrypoint", formatList(config.Entrypoint))
+		field("Cmd", formatList(config.Cmd))
+		field("Working dir", valueOrNone(config.WorkingDir))
+		field("User", valueOrNone(config.User))
+	}
+
+	if hostConfig := info.HostConfig; hostConfig != nil {
+		section("Restart policy")
+		policy := valueOrNone(string(hostConfig.RestartPolicy.Name))
+		if hostConfig.RestartPolicy.MaximumRetryCount > 0 {
+			policy = fmt.Sprintf("%s (max %d retries)", policy, hostConfig.RestartPolicy.MaximumRetryCount)
+		}
+		field("Policy", policy)
+
+		section("Resource limits")
+		resources := hostConfig.Resources
+		field("CPUs", formatLimit(resources.NanoCPUs, func(v int64) string { return fmt.Sprintf("%.2f", float64(v)/1e9) }))
+		field("CPU shares", formatLimit(resources.CPUShares, nil))
+		field("CPU quota / period", fmt.Sprintf("%s / %s", formatLimit(resources.CPUQuota, nil), formatLimit(resources.CPUPeriod, nil)))
+		field("CPU set", valueOrNone(resources.CpusetCpus))
+		field("Memory", formatLimit(resources.Memory, formatBytes))
+		field("Memory reservation", formatLimit(resources.MemoryReservation, formatBytes))
+		field("Memory + swap", formatLimit(resources.MemorySwap, formatBytes))
+		if resources.PidsLimit != nil {
+			field("PIDs", formatLimit(*resources.PidsLimit, nil))
+		} else {
+			field("PIDs", "[gray]unlimited[white]")
+		}
+	}
+
+	section("Mounts")
+	if len(info.Mounts) == 0 {
+		sb.WriteString("  [gray]none[white]\n")
+	}
+	for _, mount := range info.Mounts {
+		source := mount.Source
+		if mount.Name != "" {
+			source = mount.Name
+		}
+		mode := "rw"
+		if !mount.RW {
+			mode = "ro"
+		}
+		fmt.Fprintf(&sb, "  [green]%-8s[white]%s -> %s (%s)\n", mount.Type, tview.Escape(source), tview.Escape(mount.Destination), mode)
+	}
+
+	if settings := info.NetworkSettings; settings != nil {
+		section("Networks")
+		names := make([]string, 0, len(settings.Networks))
+		for name := range settings.Networks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			sb.WriteString("  [gray]none[white]\n")
+		}
+		for _, name := range names {
+			endpoint := settings.Networks[name]
+			fmt.Fprintf(&sb, "  [green]%-20s[white]ip %s, gateway %s, mac %s\n",
+				tview.Escape(name), valueOrNone(endpoint.IPAddress), valueOrNone(endpoint.Gateway), valueOrNone(endpoint.MacAddress))
+			if len(endpoint.Aliases) > 0 {
+				fmt.Fprintf(&sb, "  %-20saliases %s\n", "", tview.Escape(strings.Join(endpoint.Aliases, ", ")))
+			}
+		}
+
+		section("Ports")
+		ports := make([]nat.Port, 0, len(settings.Ports))
+		for port := range settings.Ports {
+			ports = append(ports, port)
+		}
+		nat.Sort(ports, func(i, j nat.Port) bool {
+			return i.Int() < j.Int()
+		})
+		if len(ports) == 0 {
+			sb.WriteString("  [gray]none[white]\n")
+		}
+		for _, port := range ports {
+			var hosts []string
+			for _, binding := range settings.Ports[port] {
+				hosts = append(hosts, formatHostBinding(binding))
+			}
+			if len(hosts) == 0 {
+				hosts = []string{"[gray]not published[white]"}
+			}
+			fmt.Fprintf(&sb, "  [green]%-20s[white]%s\n", port, strings.Join(hosts, ", "))
+		}
+	}
+
+	section("Labels")
+	if info.Config == nil || len(info.Config.Labels) == 0 {
+		sb.WriteString("  [gray]none[white]\n")
+	} else {
+		keys := make([]string, 0, len(info.Config.Labels))
+		for key := range info.Config.Labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&sb, "  [green]%s[white]=%s\n", tview.Escape(key), tview.Escape(info.Config.Labels[key]))
+		}
+	}
+
+	return sb.String()
+}
+
+// formatTimestamp formats the RFC 3339 timestamps of the inspect output, which
+// use the zero time for events that did not happen yet.
+func formatTimestamp(timestamp string) string {
+	parsed, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil || parsed.IsZero() || parsed.Year() <= 1 {
+		return "[gray]never[white]"
+	}
+	return fmt.Sprintf("%s (%s ago)", parsed.Local().Format(time.DateTime), units.HumanDuration(time.Since(parsed)))
+}
+
+func formatLimit(value int64, format func(int64) string) string {
+	if value <= 0 {
+		return "[gray]unlimited[white]"
+	}
+	if format == nil {
+		return fmt.Sprint(value)
+	}
+	return format(value)
+}
+
+func formatBytes(value int64) string {
+	return units.BytesSize(float64(value))
+}
+
+func formatList(values []string) string {
+	if len(values) == 0 {
+		return "[gray]none[white]"
+	}
+	return tview.Escape(strings.Join(values, " "))
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "[gray]none[white]"
+	}
+	return tview.Escape(value)
+}
@@ -0,0 +1,159 @@
+package ui
+
+import (
+	"fmt"
+	"main/internal/docker"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// diffNode is a path in the filesystem diff tree. Directories that did not
+// change themselves but contain changes have no change attached.
+type diffNode struct {
+	name     string
+	change   *docker.FileChange
+	children map[string]*diffNode
+	// counts and size aggregate the changes below the node, per kind. Only
+	// leaves are counted, as the daemon also reports the directories that
+	// contain a change as changed.
+	counts map[container.ChangeType]int
+	size   int64
+}
+
+func buildDiffTree(changes []docker.FileChange) *diffNode {
+	root := &diffNode{name: "/", children: make(map[string]*diffNode), counts: make(map[container.ChangeType]int)}
+	for i := range changes {
+		change := &changes[i]
+		node := root
+		for _, part := range strings.Split(strings.Trim(change.Path, "/"), "/") {
+			child, exists := node.children[part]
+			if !exists {
+				child = &diffNode{name: part, children: make(map[string]*diffNode), counts: make(map[container.ChangeType]int)}
+				node.children[part] = child
+			}
+			node = child
+		}
+		node.change = change
+	}
+	root.aggregate()
+	return root
+}
+
+func (n *diffNode) aggregate() {
+	for _, child := range n.children {
+		if len(child.children) == 0 {
+			if child.change != nil {
+				n.counts[child.change.Kind]++
+				if child.change.Size > 0 {
+					n.size += child.change.Size
+				}
+			}
+			continue
+		}
+		child.aggregate()
+		for kind, count := range child.counts {
+			n.counts[kind] += count
+		}
+		n.size += child.size
+	}
+}
+
+func (n *diffNode) label() string {
+	var sb strings.Builder
+	if n.change != nil {
+		sb.WriteString(formatChangeKind(n.change.Kind) + " ")
+	}
+	if len(n.children) > 0 || (n.change != nil && n.change.IsDir) {
+		fmt.Fprintf(&sb, "[blue]%s/[white]", tview.Escape(strings.TrimSuffix(n.name, "/")))
+
+		total := n.counts[container.ChangeAdd] + n.counts[container.ChangeModify] + n.counts[container.ChangeDelete]
+		fmt.Fprintf(&sb, " [gray](%d changes: [green]+%d[gray] [yellow]~%d[gray] [red]-%d[gray], %s)[white]",
+			total, n.counts[container.ChangeAdd], n.counts[container.ChangeModify], n.counts[container.ChangeDelete],
+			units.HumanSize(float64(n.size)))
+		return sb.String()
+	}
+
+	sb.WriteString(tview.Escape(n.name))
+	if n.change != nil && n.change.Size >= 0 {
+		fmt.Fprintf(&sb, " [gray](%s)[white]", units.HumanSize(float64(n.change.Size)))
+	}
+	return sb.String()
+}
+
+func (n *diffNode) treeNode(expandDepth int) *tview.TreeNode {
+	node := tview.NewTreeNode(n.label()).
+		SetSelectable(true).
+		SetExpanded(expandDepth > 0)
+
+	names := make([]string, 0, len(n.children))
+	for name := range n.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		node.AddChild(n.children[name].treeNode(expandDepth - 1))
+	}
+	return node
+}
+
+func formatChangeKind(kind container.ChangeType) string {
+	switch kind {
+	case container.ChangeAdd:
+		return "[green]A[white]"
+	case container.ChangeModify:
+		return "[yellow]C[white]"
+	case container.ChangeDelete:
+		return "[red]D[white]"
+	}
+	return " "
+}
+
+// createDiffTree shows the filesystem changes of a container as a tree, in
+// which Enter collapses and expands directories. The changes are read in the
+// background, as looking up their sizes takes a request per path.
+func createDiffTree(containerID string) *tview.TreeView {
+	loading := tview.NewTreeNode("/").
+		AddChild(tview.NewTreeNode("[gray]reading filesystem changes...[white]").SetSelectable(false))
+	tree := tview.NewTreeView().SetRoot(loading).SetCurrentNode(loading)
+
+	go func() {
+		changes, err := clientFor(containerID).GetContainerChanges(containerID)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				loading.ClearChildren().
+					AddChild(tview.NewTreeNode(fmt.Sprintf("[red]Error reading filesystem changes: %s[white]", tview.Escape(err.Error()))).SetSelectable(false))
+				return
+			}
+
+			root := buildDiffTree(changes).treeNode(2)
+			if len(changes) == 0 {
+				root.AddChild(tview.NewTreeNode("[gray]no changes compared to the image[white]").SetSelectable(false))
+			}
+			tree.SetRoot(root).SetCurrentNode(root)
+		})
+	}()
+
+	tree.SetBorder(true)
+	tree.SetTitle(fmt.Sprintf("  Changes of %s - [green]A[white]dded [yellow]C[white]hanged [red]D[white]eleted - Press [orange:-:b]ESC[white:-:B] to go back  ",
+		containerModel.name(containerID)))
+	tree.SetSelectedFunc(func(node *tview.TreeNode) {
+		node.SetExpanded(!node.IsExpanded())
+	})
+	tree.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'l':
+			tree.GetCurrentNode().SetExpanded(true)
+			return nil
+		case 'h':
+			tree.GetCurrentNode().SetExpanded(false)
+			return nil
+		}
+		return event
+	})
+	return tree
+}
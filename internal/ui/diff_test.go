@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"main/internal/docker"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+func TestBuildDiffTreeCountsLeaves(t *testing.T) {
+	root := buildDiffTree([]docker.FileChange{
+		{Path: "/etc", Kind: container.ChangeModify, IsDir: true, Size: -1},
+		{Path: "/etc/app", Kind: container.ChangeAdd, IsDir: true, Size: -1},
+		{Path: "/etc/app/config.toml", Kind: container.ChangeAdd, Size: 100},
+		{Path: "/etc/hosts", Kind: container.ChangeModify, Size: 20},
+		{Path: "/var/cache", Kind: container.ChangeDelete, Size: -1},
+	})
+
+	tests := []struct {
+		node *diffNode
+		want map[container.ChangeType]int
+		size int64
+	}{
+		{root, map[container.ChangeType]int{container.ChangeAdd: 1, container.ChangeModify: 1, container.ChangeDelete: 1}, 120},
+		{root.children["etc"], map[container.ChangeType]int{container.ChangeAdd: 1, container.ChangeModify: 1}, 120},
+		{root.children["etc"].children["app"], map[container.ChangeType]int{container.ChangeAdd: 1}, 100},
+	}
+	for _, test := range tests {
+		for _, kind := range []container.ChangeType{container.ChangeAdd, container.ChangeModify, container.ChangeDelete} {
+			if got := test.node.counts[kind]; got != test.want[kind] {
+				t.Errorf("%s: got %d changes of kind %d, want %d", test.node.name, got, kind, test.want[kind])
+			}
+		}
+		if test.node.size != test.size {
+			t.Errorf("%s: got size %d, want %d", test.node.name, test.node.size, test.size)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"main/internal/docker"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-units"
+	"github.com/rivo/tview"
+)
+
+// validContainerName matches the names the daemon accepts.
+var validContainerName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]+$`)
+
+func showEditContainerForm(table *tview.Table) {
+	containerID := selectedReference(table)
+	if containerID == "" {
+		return
+	}
+
+	info, err := clientFor(containerID).InspectContainer(containerID)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+	currentName := strings.TrimPrefix(info.Name, "/")
+	resources := info.HostConfig.Resources
+
+	formatInt := func(value int64) string {
+		if value <= 0 {
+			return ""
+		}
+		return strconv.FormatInt(value, 10)
+	}
+	memory := formatMemoryLimit(resources.Memory)
+
+	form := tview.NewForm().
+		AddInputField("Name", currentName, 40, nil, nil).
+		AddInputField("CPU shares", formatInt(resources.CPUShares), 10, nil, nil).
+		AddInputField("CPU quota (µs)", formatInt(resources.CPUQuota), 10, nil, nil).
+		AddInputField("Memory (e.g. 512m)", memory, 10, nil, nil).
+		AddInputField("Restart policy", formatRestartPolicy(info.HostConfig.RestartPolicy), 20, nil, nil)
+
+	form.AddButton("Save", func() {
+		text := func(label string) string {
+			return strings.TrimSpace(form.GetFormItemByLabel(label).(*tview.InputField).GetText())
+		}
+
+		name := strings.TrimPrefix(text("Name"), "/")
+		if !validContainerName.MatchString(name) {
+			NotificationError(fmt.Errorf("invalid container name %q: only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", name))
+			return
+		}
+		update, changed, err := containerUpdateFromForm(resources, info.HostConfig.RestartPolicy,
+			text("CPU shares"), text("CPU quota (µs)"), text("Memory (e.g. 512m)"), text("Restart policy"))
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+		if name == currentName && !changed {
+			NotificationInfo("Nothing changed")
+			closeModal()
+			return
+		}
+
+		closeModal()
+		go func() {
+			if changed {
+				warnings, err := clientFor(containerID).UpdateContainer(containerID, update)
+				if err != nil {
+					NotificationError(err)
+					return
+				}
+				if len(warnings) > 0 {
+					NotificationInfo(strings.Join(warnings, "; "))
+				}
+			}
+			if name != currentName {
+				if err := clientFor(containerID).RenameContainer(containerID, name); err != nil {
+					NotificationError(err)
+					return
+				}
+			}
+			if update.MemorySwap > 0 {
+				NotificationSuccess(fmt.Sprintf("Updated %s, the memory+swap limit is now %s", name, formatMemoryLimit(update.MemorySwap)))
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Updated %s", name))
+		}()
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal(fmt.Sprintf("Edit %s", currentName), form, 60, 15)
+}
+
+// containerUpdateFromForm validates the form values and returns the update
+// for the values that differ from the current configuration. The daemon
+// ignores zero values in an update, so a limit can be changed but not removed.
+func containerUpdateFromForm(current container.Resources, currentPolicy container.RestartPolicy, shares, quota, memory, restart string) (container.UpdateConfig, bool, error) {
+	var update container.UpdateConfig
+	changed := false
+
+	parseLimit := func(name, input string, currentValue, minimum int64) (int64, error) {
+		if input == "" {
+			if currentValue > 0 {
+				return 0, fmt.Errorf("the %s of a container can be changed but not removed", name)
+			}
+			return 0, nil
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		if err != nil || value < minimum {
+			return 0, fmt.Errorf("invalid %s %q: expected a number of at least %d", name, input, minimum)
+		}
+		return value, nil
+	}
+
+	cpuShares, err := parseLimit("CPU shares", shares, current.CPUShares, 2)
+	if err != nil {
+		return update, false, err
+	}
+	if cpuShares != 0 && cpuShares != current.CPUShares {
+		update.CPUShares, changed = cpuShares, true
+	}
+
+	cpuQuota, err := parseLimit("CPU quota", quota, current.CPUQuota, 1000)
+	if err != nil {
+		return update, false, err
+	}
+	if cpuQuota != 0 && cpuQuota != current.CPUQuota {
+		update.CPUQuota, changed = cpuQuota, true
+	}
+
+	if memory == "" && current.Memory > 0 {
+		return update, false, errors.New("the memory limit of a container can be changed but not removed")
+	}
+	memoryLimit, err := parseMemory(memory)
+	if err != nil {
+		return update, false, err
+	}
+	if memoryLimit != 0 && memoryLimit < 6*units.MiB {
+		return update, false, errors.New("the memory limit must be at least 6MiB")
+	}
+	if memoryLimit != 0 && memoryLimit != current.Memory {
+		update.Memory, changed = memoryLimit, true
+		// The swap limit covers memory and swap, and one below the memory
+		// limit is rejected. It moves with the memory limit to keep the same
+		// amount of swap, unless swap is unlimited.
+		if current.MemorySwap > 0 {
+			update.MemorySwap = memoryLimit + current.MemorySwap - current.Memory
+		}
+	}
+
+	policy, err := docker.ParseRestartPolicy(restart)
+	if err != nil {
+		return update, false, err
+	}
+	if normalizeRestartPolicy(policy) != normalizeRestartPolicy(currentPolicy) {
+		update.RestartPolicy, changed = policy, true
+	}
+	return update, changed, nil
+}
+
+// formatMemoryLimit formats a memory limit in the largest unit that keeps
+// the exact value, so saving the form unchanged keeps the limit.
+func formatMemoryLimit(memory int64) string {
+	switch {
+	case memory <= 0:
+		return ""
+	case memory%units.GiB == 0:
+		return fmt.Sprintf("%dg", memory/units.GiB)
+	case memory%units.MiB == 0:
+		return fmt.Sprintf("%dm", memory/units.MiB)
+	case memory%units.KiB == 0:
+		return fmt.Sprintf("%dk", memory/units.KiB)
+	}
+	return strconv.FormatInt(memory, 10)
+}
+
+// normalizeRestartPolicy treats the empty policy name, which the daemon
+// reports for containers created without a policy, as "no".
+func normalizeRestartPolicy(policy container.RestartPolicy) container.RestartPolicy {
+	if policy.Name == "" {
+		policy.Name = container.RestartPolicyDisabled
+	}
+	return policy
+}
+
+func formatRestartPolicy(policy container.RestartPolicy) string {
+	policy = normalizeRestartPolicy(policy)
+	if policy.MaximumRetryCount > 0 {
+		return fmt.Sprintf("%s:%d", policy.Name, policy.MaximumRetryCount)
+	}
+	return string(policy.Name)
+}
@@ -0,0 +1,255 @@
+package ui
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// maxCapturedEvents is the number of events kept by the events screen, older
+// events are dropped.
+const maxCapturedEvents = 5000
+
+var (
+	eventHeaders = []string{"Time", "Type", "Action", "Actor", "Attributes"}
+	eventTypes   = []string{"all", "container", "image", "volume", "network", "daemon", "plugin", "service", "node", "secret", "config"}
+
+	eventTypeFilter   string
+	eventActionFilter string
+)
+
+// DrawEvents streams the events of the daemon into a table. Events are
+// captured while the table is paused and shown when it is resumed.
+func DrawEvents() {
+	table := setupResourceTable("Events", eventHeaders)
+	table.SetBorder(true)
+
+	flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(createNotification(), 3, 1, false).
+		AddItem(CreateFooterEvents().TextView, 1, 1, false)
+
+	var captured []events.Message
+	paused := false
+
+	updateTitle := func() {
+		title := fmt.Sprintf("Events (%d captured)", len(captured))
+		if filter := formatEventFilter(); filter != "" {
+			title += " - " + filter
+		}
+		if paused {
+			title += " - [orange::b]PAUSED[white::B]"
+		}
+		table.SetTitle(title)
+	}
+	render := func() {
+		table.Clear()
+		setTableHeaders(table, eventHeaders)
+		for _, event := range captured {
+			if matchesEventFilter(event) {
+				appendEventRow(table, event)
+			}
+		}
+		table.ScrollToEnd()
+		updateTitle()
+	}
+	render()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := make(chan events.Message)
+	go dockerClient.ListenForAllEvents(ctx, eventChan)
+	go func() {
+		for event := range eventChan {
+			app.QueueUpdateDraw(func() {
+				captured = append(captured, event)
+				if len(captured) > maxCapturedEvents {
+					captured = captured[len(captured)-maxCapturedEvents:]
+				}
+				if !paused && matchesEventFilter(event) {
+					appendEventRow(table, event)
+					table.ScrollToEnd()
+				}
+				updateTitle()
+			})
+		}
+	}()
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			cancel()
+			DrawHome()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'p', ' ':
+				paused = !paused
+				if paused {
+					updateTitle()
+				} else {
+					render()
+				}
+				return nil
+			case '/':
+				showEventFilterForm(render)
+				return nil
+			case 'c':
+				captured = nil
+				render()
+				return nil
+			case 'x':
+				showExportEventsForm(captured)
+				return nil
+			}
+		}
+		return event
+	})
+
+	app.SetRoot(flex, true).SetFocus(table)
+}
+
+func appendEventRow(table *tview.Table, event events.Message) {
+	row := table.GetRowCount()
+	timestamp := time.Unix(0, event.TimeNano).Format("2006-01-02 15:04:05.000")
+
+	actor := event.Actor.Attributes["name"]
+	if actor == "" {
+		actor = shortID(event.Actor.ID)
+	}
+
+	keys := make([]string, 0, len(event.Actor.Attributes))
+	for key := range event.Actor.Attributes {
+		if key != "name" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	attributes := make([]string, len(keys))
+	for i, key := range keys {
+		attributes[i] = key + "=" + event.Actor.Attributes[key]
+	}
+
+	table.SetCell(row, 0, tview.NewTableCell(timestamp))
+	table.SetCell(row, 1, tview.NewTableCell(string(event.Type)))
+	table.SetCell(row, 2, tview.NewTableCell(formatEventAction(event.Action)))
+	table.SetCell(row, 3, tview.NewTableCell(tview.Escape(actor)))
+	table.SetCell(row, 4, tview.NewTableCell("[gray]"+tview.Escape(strings.Join(attributes, ", "))))
+}
+
+func formatEventAction(action events.Action) string {
+	switch action {
+	case events.ActionCreate, events.ActionStart, events.ActionUnPause, events.ActionConnect, events.ActionPull:
+		return fmt.Sprintf("[green]%s[white]", action)
+	case events.ActionDie, events.ActionKill, events.ActionOOM, events.ActionDestroy, events.ActionDelete, events.ActionRemove:
+		return fmt.Sprintf("[red]%s[white]", action)
+	case events.ActionStop, events.ActionPause, events.ActionDisconnect:
+		return fmt.Sprintf("[yellow]%s[white]", action)
+	}
+	if strings.HasPrefix(string(action), string(events.ActionHealthStatus)) {
+		return fmt.Sprintf("[blue]%s[white]", tview.Escape(string(action)))
+	}
+	return tview.Escape(string(action))
+}
+
+func matchesEventFilter(event events.Message) bool {
+	if eventTypeFilter != "" && string(event.Type) != eventTypeFilter {
+		return false
+	}
+	return eventActionFilter == "" || strings.Contains(string(event.Action), eventActionFilter)
+}
+
+func formatEventFilter() string {
+	var filters []string
+	if eventTypeFilter != "" {
+		filters = append(filters, "type="+eventTypeFilter)
+	}
+	if eventActionFilter != "" {
+		filters = append(filters, "action="+tview.Escape(eventActionFilter))
+	}
+	return strings.Join(filters, " ")
+}
+
+func showEventFilterForm(onApply func()) {
+	selected := 0
+	for i, eventType := range eventTypes {
+		if eventType == eventTypeFilter {
+			selected = i
+		}
+	}
+
+	form := tview.NewForm().
+		AddDropDown("Type", eventTypes, selected, nil).
+		AddInputField("Action contains", eventActionFilter, 30, nil, nil)
+
+	form.AddButton("Apply", func() {
+		_, eventType := form.GetFormItemByLabel("Type").(*tview.DropDown).GetCurrentOption()
+		if eventType == "all" {
+			eventType = ""
+		}
+		eventTypeFilter = eventType
+		eventActionFilter = strings.TrimSpace(form.GetFormItemByLabel("Action contains").(*tview.InputField).GetText())
+		closeModal()
+		onApply()
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Filter events", form, 60, 9)
+}
+
+func showExportEventsForm(captured []events.Message) {
+	if len(captured) == 0 {
+		NotificationInfo("No events captured yet")
+		return
+	}
+
+	workingDir, _ := os.Getwd()
+	defaultPath := filepath.Join(workingDir, fmt.Sprintf("docker-events-%s.jsonl", time.Now().Format("20060102-150405")))
+	form := tview.NewForm().
+		AddInputField("File", defaultPath, 60, nil, nil)
+
+	form.AddButton("Export", func() {
+		path := strings.TrimSpace(form.GetFormItemByLabel("File").(*tview.InputField).GetText())
+		closeModal()
+		if err := exportEvents(path, captured); err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("Exported %d events to %s", len(captured), path))
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Export events", form, 80, 7)
+}
+
+// exportEvents writes events as JSON lines, in the format of
+// docker events --format '{{json .}}'.
+func exportEvents(path string, captured []events.Message) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, event := range captured {
+		if err := encoder.Encode(event); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,268 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"main/internal/docker"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/alecthomas/chroma"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// maxPreviewSize is the number of bytes of a file shown in the preview.
+const maxPreviewSize = 512 * 1024
+
+var fileHeaders = []string{"Name", "Type", "Size"}
+
+// DrawFiles shows a browser for the filesystem of a container, starting in
+// dir.
+func DrawFiles(containerID, dir string) {
+	name := containerModel.name(containerID)
+	table := setupResourceTable(fmt.Sprintf("Files of %s: %s", name, dir), fileHeaders)
+	table.SetBorder(true)
+
+	flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(createNotification(), 3, 1, false).
+		AddItem(CreateFooterFiles().TextView, 1, 1, false)
+
+	entries, err := clientFor(containerID).ListDirectory(containerID, dir)
+	if err != nil {
+		NotificationError(err)
+	}
+
+	row := 1
+	if dir != "/" {
+		table.SetCell(row, 0, tview.NewTableCell("[::b]..[::B]").SetReference(docker.FileEntry{Name: "..", IsDir: true}))
+		table.SetCell(row, 1, tview.NewTableCell("[gray]parent"))
+		table.SetCell(row, 2, tview.NewTableCell(""))
+		row++
+	}
+	for _, entry := range entries {
+		kind, label, size := "file", tview.Escape(entry.Name), ""
+		switch {
+		case entry.IsDir:
+			kind, label = "[blue]directory[white]", "[blue]"+label+"/[white]"
+		case entry.IsLink:
+			kind, label = "[aqua]link[white]", "[aqua]"+label+"[white]"
+		}
+		if entry.Size >= 0 && !entry.IsDir {
+			size = units.HumanSize(float64(entry.Size))
+		}
+		table.SetCell(row, 0, tview.NewTableCell(label).SetReference(entry))
+		table.SetCell(row, 1, tview.NewTableCell(kind))
+		table.SetCell(row, 2, tview.NewTableCell(size))
+		row++
+	}
+	if table.GetRowCount() > 1 {
+		table.Select(1, 0)
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		return handleFileInput(event, table, containerID, dir)
+	})
+
+	app.SetRoot(flex, true).SetFocus(table)
+}
+
+func handleFileInput(event *tcell.EventKey, table *tview.Table, containerID, dir string) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		DrawHome()
+		return nil
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		DrawFiles(containerID, path.Dir(dir))
+		return nil
+	case tcell.KeyEnter:
+		openFileEntry(table, containerID, dir)
+		return nil
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'd':
+			showDownloadForm(table, containerID, dir)
+			return nil
+		case 'u':
+			showUploadForm(containerID, dir)
+			return nil
+		}
+	}
+	return event
+}
+
+func selectedFileEntry(table *tview.Table) (docker.FileEntry, bool) {
+	row, _ := table.GetSelection()
+	if row < 1 || row >= table.GetRowCount() {
+		return docker.FileEntry{}, false
+	}
+	entry, ok := table.GetCell(row, 0).GetReference().(docker.FileEntry)
+	return entry, ok
+}
+
+func openFileEntry(table *tview.Table, containerID, dir string) {
+	entry, ok := selectedFileEntry(table)
+	if !ok {
+		return
+	}
+
+	target := path.Join(dir, entry.Name)
+	if entry.IsDir {
+		DrawFiles(containerID, target)
+		return
+	}
+	// A link is followed when it points to a directory and previewed
+	// otherwise.
+	if entry.IsLink {
+		if _, err := clientFor(containerID).ListDirectory(containerID, target); err == nil {
+			DrawFiles(containerID, target)
+			return
+		}
+	}
+	previewFile(containerID, dir, target)
+}
+
+func previewFile(containerID, dir, filePath string) {
+	content, truncated, err := clientFor(containerID).ReadFile(containerID, filePath, maxPreviewSize)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+
+	text := highlightFile(path.Base(filePath), content)
+	if truncated {
+		text += fmt.Sprintf("\n\n[orange]Preview truncated at %s, press ESC and d to download the whole file.[white]", units.HumanSize(maxPreviewSize))
+	}
+	drawTextScreen(filePath, text, func() {
+		DrawFiles(containerID, dir)
+	})
+}
+
+// highlightFile highlights the content of a file based on its name, or
+// describes it when it is binary.
+func highlightFile(name string, content []byte) string {
+	if bytes.IndexByte(content[:min(len(content), 8000)], 0) >= 0 {
+		return fmt.Sprintf("[gray]%s is a binary file (%s), press ESC and d to download it.[white]", tview.Escape(name), units.HumanSize(float64(len(content))))
+	}
+
+	source := string(content)
+	lexer := lexers.Match(name)
+	if lexer == nil {
+		lexer = lexers.Analyse(source)
+	}
+	if lexer == nil {
+		return tview.Escape(source)
+	}
+
+	tokens, err := chroma.Coalesce(lexer).Tokenise(nil, source)
+	if err != nil {
+		return tview.Escape(source)
+	}
+	return formatTokens(tokens, styles.Get("monokai"))
+}
+
+// formatTokens writes highlighted tokens with tview colour tags. Text is
+// escaped in runs of the same style, so brackets in the file are not taken as
+// tags, also when a bracket and its closing bracket are different tokens.
+func formatTokens(tokens chroma.Iterator, style *chroma.Style) string {
+	var sb, run strings.Builder
+	tag := ""
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+		if tag == "" {
+			sb.WriteString(tview.Escape(run.String()))
+		} else {
+			fmt.Fprintf(&sb, "%s%s[-::-]", tag, tview.Escape(run.String()))
+		}
+		run.Reset()
+	}
+
+	for token := tokens(); token != chroma.EOF; token = tokens() {
+		if tokenTag := styleTag(style.Get(token.Type)); tokenTag != tag {
+			flush()
+			tag = tokenTag
+		}
+		run.WriteString(token.Value)
+	}
+	flush()
+	return sb.String()
+}
+
+func styleTag(entry chroma.StyleEntry) string {
+	colour, attributes := "-", "-"
+	if entry.Colour.IsSet() {
+		colour = entry.Colour.String()
+	}
+	if entry.Bold == chroma.Yes {
+		attributes = "b"
+	}
+	if colour == "-" && attributes == "-" {
+		return ""
+	}
+	return fmt.Sprintf("[%s::%s]", colour, attributes)
+}
+
+func showDownloadForm(table *tview.Table, containerID, dir string) {
+	entry, ok := selectedFileEntry(table)
+	if !ok || entry.Name == ".." {
+		return
+	}
+	workingDir, _ := os.Getwd()
+	source := path.Join(dir, entry.Name)
+
+	form := tview.NewForm().
+		AddInputField("Local directory", workingDir, 50, nil, nil)
+
+	form.AddButton("Download", func() {
+		localDir := strings.TrimSpace(form.GetFormItemByLabel("Local directory").(*tview.InputField).GetText())
+		closeModal()
+		NotificationInfo(fmt.Sprintf("Downloading %s...", source))
+		go func() {
+			saved, err := clientFor(containerID).DownloadPath(containerID, source, localDir)
+			if err != nil {
+				NotificationError(err)
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Downloaded %s to %s", source, saved))
+		}()
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal(fmt.Sprintf("Download %s", entry.Name), form, 80, 7)
+}
+
+func showUploadForm(containerID, dir string) {
+	form := tview.NewForm().
+		AddInputField("Local path", "", 50, nil, nil)
+
+	form.AddButton("Upload", func() {
+		localPath := strings.TrimSpace(form.GetFormItemByLabel("Local path").(*tview.InputField).GetText())
+		if localPath == "" {
+			NotificationError(fmt.Errorf("a local file or directory is required"))
+			return
+		}
+
+		closeModal()
+		NotificationInfo(fmt.Sprintf("Uploading %s...", localPath))
+		go func() {
+			if err := clientFor(containerID).UploadPath(containerID, localPath, dir); err != nil {
+				NotificationError(err)
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Uploaded %s to %s", localPath, dir))
+			app.QueueUpdateDraw(func() {
+				DrawFiles(containerID, dir)
+			})
+		}()
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal(fmt.Sprintf("Upload to %s", dir), form, 80, 7)
+}
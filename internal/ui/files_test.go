@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rivo/tview"
+)
+
+func TestHighlightFileKeepsBrackets(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		highlighted bool
+	}{
+		{"list.json", `{"names": ["x","y"], "nested": [[1], []]}`, true},
+		{"config.toml", "[db]\nhost = \"localhost\"\nports = [5432, 5433]\n\n[[servers]]\nname = \"a\"\n", true},
+		{"notes.txt", "[red] is not a colour tag [::b]\n", false},
+	}
+	for _, test := range tests {
+		text := highlightFile(test.name, []byte(test.content))
+		if test.highlighted && !strings.Contains(text, "[#") {
+			t.Errorf("%s: not highlighted: %q", test.name, text)
+		}
+
+		view := tview.NewTextView().SetDynamicColors(true)
+		view.SetText(text)
+		if rendered := view.GetText(true); rendered != test.content {
+			t.Errorf("%s: rendered\n%q\nwant\n%q", test.name, rendered, test.content)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var (
+	containerFilter      string
+	containerFilterInput *tview.InputField
+)
+
+func createFilterInput(table *tview.Table) *tview.InputField {
+	containerFilterInput = tview.NewInputField().
+		SetLabel("/").
+		SetText(containerFilter).
+		SetFieldTextColor(tcell.ColorWhite).
+		SetPlaceholderTextColor(tcell.ColorLightGray).
+		SetPlaceholder("name, ~fuzzy or label=key=value")
+
+	containerFilterInput.SetChangedFunc(func(text string) {
+		containerFilter = text
+		renderContainerTable(table, false)
+	})
+
+	containerFilterInput.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			containerFilterInput.SetText("")
+		}
+		if containerFilter == "" {
+			flex.ResizeItem(containerFilterInput, 0, 0)
+		}
+		app.SetFocus(table)
+	})
+
+	return containerFilterInput
+}
+
+func filterInputHeight() int {
+	if containerFilter != "" {
+		return 1
+	}
+	return 0
+}
+
+func showFilterInput() {
+	flex.ResizeItem(containerFilterInput, 1, 0)
+	app.SetFocus(containerFilterInput)
+}
+
+// matchesFilter reports whether the container matches every whitespace
+// separated term of the filter. A term is either a substring of the name,
+// image, ID, state, status or a label, a fuzzy match on name or image when
+// prefixed with "~", or a label match in the form label=key or label=key=value.
+func matchesFilter(container types.Container, filter string) bool {
+	for _, term := range strings.Fields(strings.ToLower(filter)) {
+		if !matchesTerm(container, term) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesTerm(container types.Container, term string) bool {
+	name := strings.ToLower(strings.TrimPrefix(container.Names[0], "/"))
+	image := strings.ToLower(container.Image)
+
+	if label, ok := strings.CutPrefix(term, "label="); ok {
+		key, value, hasValue := strings.Cut(label, "=")
+		for labelKey, labelValue := range container.Labels {
+			if strings.ToLower(labelKey) == key && (!hasValue || strings.ToLower(labelValue) == value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if pattern, ok := strings.CutPrefix(term, "~"); ok {
+		return fuzzyMatch(name, pattern) || fuzzyMatch(image, pattern)
+	}
+
+	fields := []string{name, image, container.ID, container.State, strings.ToLower(container.Status)}
+	for key, value := range container.Labels {
+		fields = append(fields, strings.ToLower(key+"="+value))
+	}
+	for _, field := range fields {
+		if strings.Contains(field, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// fuzzyMatch reports whether all runes of pattern appear in text in order.
+func fuzzyMatch(text, pattern string) bool {
+	remaining := []rune(pattern)
+	for _, r := range text {
+		if len(remaining) == 0 {
+			break
+		}
+		if r == remaining[0] {
+			remaining = remaining[1:]
+		}
+	}
+	return len(remaining) == 0
+}
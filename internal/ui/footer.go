@@ -27,7 +27,120 @@ func CreateFooterHome() *Footer {
 			createSection("2", "all") +
 			createSection("C-d", "remove") +
 			createSection("C-r", "start") +
-			createSection("C-s", "stop"),
+			createSection("C-s", "stop") +
+			createSection("C-t", "restart") +
+			createSection("C-p/C-u", "pause/unpause") +
+			createSection("C-k", "kill") +
+			createSection("space", "mark") +
+			createSection("s/S", "sort") +
+			createSection("/", "filter") +
+			createSection("H", "health") +
+			createSection("p", "ports") +
+			createSection("r", "run") +
+			createSection("e", "edit") +
+			createSection("f", "files") +
+			createSection("i", "images") +
+			createSection("v", "volumes") +
+			createSection("n", "networks") +
+			createSection("N", "connect network") +
+			createSection("u", "disk usage") +
+			createSection("E", "events") +
+			createSection("x", "context") +
+			createSection("A", "all hosts") +
+			createSection("c", "compose"),
+	)
+	return f
+}
+
+func CreateFooterImages() *Footer {
+	f := NewFooter()
+	f.TextView.SetBackgroundColor(tcell.GetColor(userTheme.Footer.Background))
+	f.TextView.SetText(
+		createSection("ESC", "back") +
+			createSection("a", "inspect") +
+			createSection("c", "containers") +
+			createSection("r", "run") +
+			createSection("C-d", "remove") +
+			createSection("C-f", "force remove"),
+	)
+	return f
+}
+
+func CreateFooterVolumes() *Footer {
+	f := NewFooter()
+	f.TextView.SetBackgroundColor(tcell.GetColor(userTheme.Footer.Background))
+	f.TextView.SetText(
+		createSection("ESC", "back") +
+			createSection("a", "inspect") +
+			createSection("n", "create") +
+			createSection("f", "dangling only") +
+			createSection("C-d", "remove"),
+	)
+	return f
+}
+
+func CreateFooterNetworks() *Footer {
+	f := NewFooter()
+	f.TextView.SetBackgroundColor(tcell.GetColor(userTheme.Footer.Background))
+	f.TextView.SetText(
+		createSection("ESC", "back") +
+			createSection("ENTER", "details") +
+			createSection("a", "inspect") +
+			createSection("n", "create") +
+			createSection("c", "connect") +
+			createSection("x", "disconnect") +
+			createSection("C-d", "remove"),
+	)
+	return f
+}
+
+func CreateFooterFiles() *Footer {
+	f := NewFooter()
+	f.TextView.SetBackgroundColor(tcell.GetColor(userTheme.Footer.Background))
+	f.TextView.SetText(
+		createSection("ESC", "back") +
+			createSection("ENTER", "open") +
+			createSection("BACKSPACE", "parent") +
+			createSection("d", "download") +
+			createSection("u", "upload"),
+	)
+	return f
+}
+
+func CreateFooterProcesses() *Footer {
+	f := NewFooter()
+	f.TextView.SetBackgroundColor(tcell.GetColor(userTheme.Footer.Background))
+	f.TextView.SetText(
+		createSection("ESC", "back") +
+			createSection("s/S", "sort") +
+			createSection("k", "send signal"),
+	)
+	return f
+}
+
+func CreateFooterDiskUsage() *Footer {
+	f := NewFooter()
+	f.TextView.SetBackgroundColor(tcell.GetColor(userTheme.Footer.Background))
+	f.TextView.SetText(
+		createSection("ESC", "back") +
+			createSection("c", "prune containers") +
+			createSection("i/I", "prune dangling/unused images") +
+			createSection("v", "prune volumes") +
+			createSection("b", "prune build cache") +
+			createSection("a", "prune all"),
+	)
+	return f
+}
+
+func CreateFooterEvents() *Footer {
+	f := NewFooter()
+	f.TextView.SetBackgroundColor(tcell.GetColor(userTheme.Footer.Background))
+	f.TextView.SetText(
+		createSection("ESC", "back") +
+			createSection("p", "pause") +
+			createSection("/", "filter") +
+			createSection("c", "clear") +
+			createSection("x", "export"),
 	)
 	return f
 }
@@ -41,7 +154,10 @@ func CreateFooterLogs() *Footer {
 
 func logsFooterText() string {
 	return createSection("?", "help") +
+		createSection("a", "details") +
 		createSection("e", "environment") +
+		createSection("d", "diff") +
+		createSection("t", "processes") +
 		createSection("v", "shell") +
 		createSection("Scroll", strconv.FormatBool(ScrollOnNewLogEntry))
 }
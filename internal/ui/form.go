@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+func showFormModal(title string, form *tview.Form, width, height int) {
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("  %s - Press [orange:-:b]ESC[white:-:B] to exit  ", title))
+	form.SetTitleAlign(tview.AlignCenter)
+	form.SetCancelFunc(closeModal)
+
+	pages := tview.NewPages().
+		AddPage("main", flex, true, true).
+		AddPage("modal", createCenteredModal(form, width, height), true, true)
+
+	app.SetRoot(pages, true).SetFocus(form)
+}
+
+func closeModal() {
+	app.SetRoot(flex, true).SetFocus(flex)
+}
+
+// parseKeyValues parses a comma separated list of key=value pairs, as
+// entered in form fields for labels and options.
+func parseKeyValues(input string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(input, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, found := strings.Cut(pair, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid key=value pair: %q", pair)
+		}
+		values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return values, nil
+}
+
+func formatKeyValues(values map[string]string) string {
+	pairs := make([]string, 0, len(values))
+	for key, value := range values {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/rivo/tview"
+)
+
+func formatHealth(health string) string {
+	switch health {
+	case types.Healthy:
+		return fmt.Sprintf("[green:black]%s[white:black]", health)
+	case types.Unhealthy:
+		return fmt.Sprintf("[red:black]%s[white:black]", health)
+	case types.Starting:
+		return fmt.Sprintf("[yellow:black]%s[white:black]", health)
+	}
+	return ""
+}
+
+// healthFromStatus reads the health state from the status text of a container
+// list entry, e.g. "Up 2 minutes (unhealthy)".
+func healthFromStatus(status string) string {
+	switch {
+	case strings.Contains(status, "(healthy)"):
+		return types.Healthy
+	case strings.Contains(status, "(unhealthy)"):
+		return types.Unhealthy
+	case strings.Contains(status, "(health: starting)"):
+		return types.Starting
+	}
+	return ""
+}
+
+func showHealthDetails(table *tview.Table) {
+	containerID := selectedReference(table)
+	if containerID == "" {
+		return
+	}
+
+	health, err := clientFor(containerID).GetContainerHealth(containerID)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+	if health.Config == nil && health.State == nil {
+		NotificationInfo(fmt.Sprintf("%s has no healthcheck", health.Name))
+		return
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[orange::b]Healthcheck[white::B]\n")
+	if config := health.Config; config != nil {
+		fmt.Fprintf(&sb, "[orange]%-16s[white]%s\n", "Test:", tview.Escape(strings.Join(config.Test, " ")))
+		fmt.Fprintf(&sb, "[orange]%-16s[white]%s\n", "Interval:", formatHealthDuration(config.Interval, 30*time.Second))
+		fmt.Fprintf(&sb, "[orange]%-16s[white]%s\n", "Timeout:", formatHealthDuration(config.Timeout, 30*time.Second))
+		fmt.Fprintf(&sb, "[orange]%-16s[white]%s\n", "Start period:", formatHealthDuration(config.StartPeriod, 0))
+		retries := config.Retries
+		if retries == 0 {
+			retries = 3
+		}
+		fmt.Fprintf(&sb, "[orange]%-16s[white]%d\n", "Retries:", retries)
+	}
+
+	if state := health.State; state != nil {
+		fmt.Fprintf(&sb, "\n[orange::b]State[white::B]\n")
+		fmt.Fprintf(&sb, "[orange]%-16s[white]%s\n", "Status:", formatHealth(state.Status))
+		fmt.Fprintf(&sb, "[orange]%-16s[white]%d\n", "Failing streak:", state.FailingStreak)
+
+		fmt.Fprintf(&sb, "\n[orange::b]Recent probes[white::B] (newest first)\n")
+		for i := len(state.Log) - 1; i >= 0; i-- {
+			probe := state.Log[i]
+			exitColor := "green"
+			if probe.ExitCode != 0 {
+				exitColor = "red"
+			}
+			fmt.Fprintf(&sb, "\n[gray]%s[white]  exit [%s]%d[white]  took %s\n",
+				probe.Start.Local().Format(time.DateTime),
+				exitColor,
+				probe.ExitCode,
+				probe.End.Sub(probe.Start).Round(time.Millisecond))
+			output := strings.TrimSpace(probe.Output)
+			if output != "" {
+				fmt.Fprintf(&sb, "  %s\n", tview.Escape(strings.ReplaceAll(output, "\n", "\n  ")))
+			}
+		}
+	}
+
+	cancelEventListener()
+	drawTextScreen(fmt.Sprintf("Health of %s", health.Name), sb.String(), DrawHome)
+}
+
+func formatHealthDuration(duration, fallback time.Duration) string {
+	if duration == 0 {
+		return fallback.String() + " (default)"
+	}
+	return duration.String()
+}
@@ -1,22 +1,58 @@
 package ui
 
 import (
+	"context"
 	"fmt"
+	"main/internal/docker"
 	"strconv"
 	"strings"
 
 	"github.com/rivo/tview"
 )
 
+// helperView is the header of the home screen, which is updated when the
+// connection to the daemon is lost or restored.
+var helperView *tview.TextView
+
 func CreateHelper() *tview.TextView {
-	header := tview.NewTextView().SetDynamicColors(true).SetWordWrap(true)
+	helperView = tview.NewTextView().SetDynamicColors(true).SetWordWrap(true)
+	refreshHelper()
+	return helperView
+}
+
+// refreshHelper fetches the header values in the background, so a daemon that
+// is slow to answer does not block the UI. It must be called from the UI
+// goroutine.
+func refreshHelper() {
+	header := helperView
+	hosts := activeHosts()
+	endpointValue := formatHostStatus(hosts)
+	if !aggregatedView {
+		endpoint := dockerClient.Endpoint()
+		endpointValue = fmt.Sprintf("%s (%s) %s", endpoint.Name, endpoint.Host, formatConnectionState(dockerClient.ConnectionState()))
+	}
+	onlyRunning := showOnlyRunning
 
+	go func() {
+		text := helperText(endpointValue, hosts, onlyRunning)
+		app.QueueUpdateDraw(func() {
+			header.SetText(text)
+		})
+	}()
+}
+
+func helperText(endpointValue string, hosts []*daemonHost, onlyRunning bool) string {
+	containers := 0
+	for _, host := range hosts {
+		containers += len(host.client.GetContainers(onlyRunning))
+	}
 	headerValues := map[string]string{
+		"Endpoint":      endpointValue,
 		"ClientVersion": dockerClient.GetDockerVersion(),
-		"Containers":    strconv.Itoa(len(dockerClient.GetContainers(showOnlyRunning))),
+		"Containers":    strconv.Itoa(containers),
 		"Images":        strconv.Itoa(len(dockerClient.GetImages())),
 	}
-	keys := []string{"ClientVersion", "Containers", "Images"}
+	keys := []string{"Endpoint", "ClientVersion", "Containers", "Images"}
 
 	maxLength := 0
 	for key := range headerValues {
@@ -32,6 +68,39 @@ func CreateHelper() *tview.TextView {
 		fmt.Fprintf(&sb, "[orange]%s:[white]%*s%s\n", key, padding+1, "", value)
 	}
 
-	header.SetText(sb.String())
-	return header
+	return sb.String()
+}
+
+func formatConnectionState(state docker.ConnectionState) string {
+	if state.Status == docker.StatusConnected {
+		return "[green]connected[white]"
+	}
+	return fmt.Sprintf("[red]disconnected[white] (%s), [yellow]reconnecting in %s[white]",
+		tview.Escape(state.Err.Error()), state.Retry)
+}
+
+// watchConnection keeps the header up to date with the connection to the
+// daemon, tells when it is lost and restored, and lists the containers again
+// once the daemon is back.
+func watchConnection() {
+	status := docker.StatusConnected
+	go dockerClient.WatchConnection(context.Background(), func(state docker.ConnectionState) {
+		app.QueueUpdateDraw(func() {
+			refreshHelper()
+			if state.Status == status {
+				return
+			}
+			status = state.Status
+
+			endpoint := dockerClient.Endpoint()
+			if state.Status == docker.StatusConnected {
+				if monitor := containerStats; monitor != nil && monitor.ctx.Err() == nil {
+					refreshContainerTable(monitor.ctx, monitor.table)
+				}
+				NotificationSuccess(fmt.Sprintf("Reconnected to %s (%s)", endpoint.Name, endpoint.Host))
+				return
+			}
+			NotificationError(fmt.Errorf("lost connection to %s (%s): %w", endpoint.Name, endpoint.Host, state.Err))
+		})
+	})
 }
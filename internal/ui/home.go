@@ -6,7 +6,8 @@ import (
 	"log"
 	"main/internal/config"
 	"main/internal/docker"
-	"sync"
+	"strings"
+	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/events"
@@ -17,49 +18,57 @@ import (
 var (
 	app                 *tview.Application
 	dockerClient        = docker.DockerWrapper{}
-	containerMap        = make(map[string]int)
-	mapMutex            sync.Mutex // Mutex for synchronizing access to containerMap
 	userTheme           = config.LoadTheme()
 	userConf            = config.LoadConfig()
 	showOnlyRunning     = userConf.OnlyRunningOnStartup
 	ScrollOnNewLogEntry bool
 	flex                *tview.Flex
 	notificationView    *tview.TextView
+	cancelEventListener context.CancelFunc
+	containerHeaders    = []string{"ID", "Container", "Image", "Uptime", "Status", "Health", "CPU / MEM", "Ports"}
+	killSignals         = []string{"SIGTERM", "SIGKILL", "SIGHUP", "SIGINT", "SIGQUIT", "SIGUSR1", "SIGUSR2"}
 )
 
+const eventCoalesceDelay = 250 * time.Millisecond
+
 func Start() {
 	app = tview.NewApplication()
 	dockerClient.NewClient(*userConf)
 	DrawHome()
+	watchConnection()
+
+	if err := app.Run(); err != nil {
+		panic(err)
+	}
 }
 
 func DrawHome() {
+	table := createContainerList()
+
 	flex = tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(CreateHelper(), 4, 1, false).
-		AddItem(createContainerList(), 0, 1, true).
+		AddItem(CreateHelper(), 5, 1, false).
+		AddItem(createFilterInput(table), filterInputHeight(), 0, false).
+		AddItem(table, 0, 1, true).
 		AddItem(createNotification(), 3, 1, false).
 		AddItem(CreateFooterHome().TextView, 1, 1, true)
 
-	if err := app.SetRoot(flex, true).SetFocus(flex).Run(); err != nil {
-		panic(err)
-	}
+	app.SetRoot(flex, true).SetFocus(flex)
 }
 
 func createContainerList() *tview.Table {
 	table := setupContainerTable()
-	initialContainers := dockerClient.GetContainers(showOnlyRunning)
-	updateTableWithContainers(table, initialContainers)
 
 	ctx, cancel := context.WithCancel(context.Background())
+	cancelEventListener = cancel
+	containerStats = newStatsMonitor(ctx, table)
+	updateFilteredContainers(table)
+
 	eventChan := make(chan events.Message)
 
 	startDockerEventListener(ctx, eventChan, table)
 
 	table.SetSelectedFunc(func(row, column int) {
-		if !showOnlyRunning {
-			row -= 1
-		}
-		handleContainerSelection(row, initialContainers, cancel, table)
+		handleContainerSelection(row, cancel, table)
 	})
 	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		return handleInput(event, table)
@@ -75,8 +84,7 @@ func setupContainerTable() *tview.Table {
 	table.SetBackgroundColor(tcell.GetColor(userTheme.Table.Fg))
 	table.SetSelectedStyle(tcell.StyleDefault.Background(tcell.GetColor(userTheme.Table.Selected)))
 
-	headers := []string{"ID", "Container", "Image", "Uptime", "Status", "CPU / MEM"}
-	for i, header := range headers {
+	for i, header := range containerHeaderLabels() {
 		table.SetCell(0, i, tview.NewTableCell(header).
 			SetTextColor(tcell.GetColor(userTheme.Table.Headers)).
 			SetExpansion(1).
@@ -86,52 +94,120 @@ func setupContainerTable() *tview.Table {
 	return table
 }
 
+// startDockerEventListener listens to the events of every active daemon and
+// applies them to the table in batches.
 func startDockerEventListener(ctx context.Context, eventChan chan events.Message, table *tview.Table) {
-	go dockerClient.ListenForEvents(ctx, eventChan)
+	for _, host := range activeHosts() {
+		hostEvents := make(chan events.Message)
+		go host.client.ListenForEvents(ctx, hostEvents)
+		go func() {
+			for event := range hostEvents {
+				select {
+				case eventChan <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
 
 	go func() {
-		for event := range eventChan {
-			handleDockerEvent(event, table)
+		var pending []events.Message
+		var flush <-chan time.Time
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				if !isTableEvent(event.Action) {
+					continue
+				}
+				pending = append(pending, event)
+				if flush == nil {
+					flush = time.After(eventCoalesceDelay)
+				}
+			case <-flush:
+				handleDockerEvents(pending, table)
+				pending = nil
+				flush = nil
+			}
 		}
 	}()
 }
 
-func handleContainerSelection(row int, containers []types.Container, cancel context.CancelFunc, table *tview.Table) {
-	var containerID string
-
-	if showOnlyRunning {
-		var runningContainers []types.Container
-		for _, container := range containers {
-			if container.State == "running" {
-				runningContainers = append(runningContainers, container)
-			}
-		}
-		containerID = runningContainers[row-1].ID
-	} else {
-		containerID = containers[row].ID
+func handleContainerSelection(row int, cancel context.CancelFunc, table *tview.Table) {
+	switch reference := table.GetCell(row, 0).GetReference().(type) {
+	case composeProject:
+		toggleProject(table, string(reference))
+	case string:
+		cancel()
+		DrawLogs(table, reference)
 	}
+}
 
-	cancel()
-	DrawLogs(table, containerID)
+func isTableEvent(action events.Action) bool {
+	switch action {
+	case events.ActionCreate, events.ActionStart, events.ActionRestart, events.ActionStop, events.ActionDie,
+		events.ActionPause, events.ActionUnPause, events.ActionRename, events.ActionDestroy, events.ActionOOM:
+		return true
+	}
+	return strings.HasPrefix(string(action), string(events.ActionHealthStatus))
 }
 
-func handleDockerEvent(event events.Message, table *tview.Table) {
+// handleDockerEvents applies a burst of container events with a single
+// container list call. Events that only change the state of a container
+// update its row in place, everything else redraws the table.
+func handleDockerEvents(batch []events.Message, table *tview.Table) {
+	containers, hosts := listContainers()
+
 	app.QueueUpdateDraw(func() {
-		switch event.Action {
-		case "start", "stop":
-			updateTableWithContainers(table, dockerClient.GetContainers(showOnlyRunning))
-		case "destroy":
-			mapMutex.Lock()
-			defer mapMutex.Unlock()
-			if row, exists := containerMap[event.ID]; exists {
-				table.RemoveRow(row)
-				delete(containerMap, event.ID)
+		containerModel.replace(containers)
+		containerModel.setHosts(hosts)
+
+		affected := make(map[string]bool)
+		redraw := groupByProject || currentSort != sortNone
+		for _, event := range batch {
+			affected[event.Actor.ID] = true
+			containerModel.invalidate(event.Actor.ID)
+
+			switch event.Action {
+			case events.ActionCreate, events.ActionRename:
+				redraw = true
+			case events.ActionDestroy:
+				containerModel.remove(event.Actor.ID)
+				delete(markedContainers, event.Actor.ID)
+				redraw = true
+			case events.ActionStart, events.ActionStop, events.ActionDie:
+				redraw = redraw || showOnlyRunning
+			}
+		}
+
+		if redraw {
+			renderContainerTable(table, false)
+			return
+		}
+
+		for id := range affected {
+			container, exists := containerModel.get(id)
+			row, shown := findContainerRow(table, id)
+			if !exists || !shown {
+				continue
 			}
+			renderContainerRow(table, row, container)
+			fetchContainerDetails(table, id)
 		}
+		containerStats.watch(runningContainerIDs(visibleContainerIDs(table)))
 	})
 }
 
 func handleInput(event *tcell.EventKey, table *tview.Table) *tcell.EventKey {
+	if project, ok := selectedProject(table); ok {
+		if handleProjectInput(event, project) == nil {
+			return nil
+		}
+	}
+
 	switch event.Key() {
 	case tcell.KeyCtrlD:
 		showRemoveConfirmation(table)
@@ -139,10 +215,87 @@ func handleInput(event *tcell.EventKey, table *tview.Table) *tcell.EventKey {
 		showStartContainerConfirmation(table)
 	case tcell.KeyCtrlS:
 		showStopConfirmation(table)
+	case tcell.KeyCtrlP:
+		showPauseConfirmation(table)
+	case tcell.KeyCtrlU:
+		showUnpauseConfirmation(table)
+	case tcell.KeyCtrlT:
+		showRestartConfirmation(table)
+	case tcell.KeyCtrlK:
+		showKillSignalPicker(table)
+	case tcell.KeyCtrlA:
+		toggleMarkAll(table)
+		return nil
 	case tcell.KeyRune:
 		switch event.Rune() {
+		case ' ':
+			toggleMark(table)
+			return nil
+		case 'm':
+			showMarkByFilterForm(table)
+			return nil
+		case 's':
+			cycleSortColumn(table)
+			return nil
+		case '/':
+			showFilterInput()
+			return nil
+		case 'H':
+			showHealthDetails(table)
+			return nil
+		case 'p':
+			showPortDetails(table)
+			return nil
+		case 'r':
+			showRunContainerForm("", nil)
+			return nil
+		case 'e':
+			showEditContainerForm(table)
+			return nil
+		case 'u':
+			cancelEventListener()
+			DrawDiskUsage()
+			return nil
+		case 'E':
+			cancelEventListener()
+			DrawEvents()
+			return nil
+		case 'x':
+			showContextPicker()
+			return nil
+		case 'A':
+			toggleAggregatedView()
+			return nil
+		case 'N':
+			showContainerNetworksForm(table)
+			return nil
+		case 'f':
+			if containerID := selectedReference(table); containerID != "" {
+				cancelEventListener()
+				DrawFiles(containerID, "/")
+			}
+			return nil
+		case 'S':
+			toggleSortDirection(table)
+			return nil
 		case '?':
 			showHelpModal(table)
+		case 'i':
+			cancelEventListener()
+			DrawImages()
+			return nil
+		case 'v':
+			cancelEventListener()
+			DrawVolumes()
+			return nil
+		case 'n':
+			cancelEventListener()
+			DrawNetworks()
+			return nil
+		case 'c':
+			groupByProject = !groupByProject
+			updateFilteredContainers(table)
+			return nil
 		case '1':
 			if !showOnlyRunning {
 				showOnlyRunning = true
@@ -195,47 +348,55 @@ func showConfirmationModal(action, containerID, message string, onConfirm func()
 }
 
 func showStopConfirmation(table *tview.Table) {
-	row, _ := table.GetSelection()
-	containerID := table.GetCell(row, 0).Text
-
-	showConfirmationModal("STOP", containerID, "", func() {
-		dockerClient.StopContainer(containerID)
-		NotificationSuccess(fmt.Sprintf("Stopping %s", containerID))
-	}, 60, 10)
+	showBulkConfirmation(table, "STOP", "", "Stopping", (*docker.DockerWrapper).StopContainer)
 }
 
 func showRemoveConfirmation(table *tview.Table) {
-	row, _ := table.GetSelection()
-	containerID := table.GetCell(row, 0).Text
+	showBulkConfirmation(table, "REMOVE", "This will delete the container!", "Removing", (*docker.DockerWrapper).RemoveContainer)
+}
 
-	showConfirmationModal("REMOVE", containerID, "This will delete the container!", func() {
-		err := dockerClient.RemoveContainer(containerID)
-		if err != nil {
-			NotificationError(err)
-		} else {
-			NotificationSuccess(fmt.Sprintf("Removing %s", containerID))
+func showStartContainerConfirmation(table *tview.Table) {
+	if ids := targetContainers(table); len(ids) == 1 {
+		container, err := clientFor(ids[0]).GetContainerInfo(ids[0])
+		if err == nil && container.State == "running" {
+			NotificationInfo(fmt.Sprintf("%s is already running", container.Name))
+			return
 		}
-	}, 60, 10)
+	}
+
+	showBulkConfirmation(table, "START", "", "Starting", (*docker.DockerWrapper).StartContainer)
 }
 
-func showStartContainerConfirmation(table *tview.Table) {
-	row, _ := table.GetSelection()
-	containerID := table.GetCell(row, 0).Text
-	container, _ := dockerClient.GetContainerInfo(containerID)
+func showPauseConfirmation(table *tview.Table) {
+	showBulkConfirmation(table, "PAUSE", "", "Pausing", (*docker.DockerWrapper).PauseContainer)
+}
+
+func showUnpauseConfirmation(table *tview.Table) {
+	showBulkConfirmation(table, "UNPAUSE", "", "Unpausing", (*docker.DockerWrapper).UnpauseContainer)
+}
+
+func showRestartConfirmation(table *tview.Table) {
+	showBulkConfirmation(table, "RESTART", "", "Restarting", (*docker.DockerWrapper).RestartContainer)
+}
 
-	if container.State == "running" {
-		NotificationInfo(fmt.Sprintf("%s is already running", container.Name))
+func showKillSignalPicker(table *tview.Table) {
+	if len(targetContainers(table)) == 0 {
 		return
 	}
 
-	showConfirmationModal("START", containerID, "", func() {
-		err := dockerClient.StartContainer(containerID)
-		if err != nil {
-			NotificationError(err)
-		} else {
-			NotificationSuccess(fmt.Sprintf("Starting %s", container.Name))
-		}
-	}, 60, 10)
+	form := tview.NewForm().
+		AddDropDown("Signal", killSignals, 0, nil)
+
+	form.AddButton("Kill", func() {
+		_, signal := form.GetFormItemByLabel("Signal").(*tview.DropDown).GetCurrentOption()
+		closeModal()
+		showBulkConfirmation(table, "KILL", "Sends "+signal+" to the container", "Sending "+signal+" to", func(client *docker.DockerWrapper, id string) error {
+			return client.KillContainer(id, signal)
+		})
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Kill container", form, 60, 7)
 }
 
 func createButtonLayout(btnYes, btnCancel *tview.Button) *tview.Flex {
@@ -296,6 +457,7 @@ func showHelpModal(table *tview.Table) {
 	}
 	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Rune() == '?' {
+			cancelEventListener()
 			DrawHome()
 			return nil
 		}
@@ -303,12 +465,34 @@ func showHelpModal(table *tview.Table) {
 	})
 
 	// Resource
-	table.SetCell(1, 0, createHelpCell("<s> (N/A)", "sort names"))
+	table.SetCell(1, 0, createHelpCell("<s>/<S>", "sort column / direction"))
 	table.SetCell(2, 0, createHelpCell("<1>", "show running containers"))
 	table.SetCell(3, 0, createHelpCell("<2>", "show all containers"))
 	table.SetCell(4, 0, createHelpCell("<C-d>", "Remove container"))
 	table.SetCell(5, 0, createHelpCell("<C-r>", "Start container"))
 	table.SetCell(6, 0, createHelpCell("<C-s>", "Stop container"))
+	table.SetCell(7, 0, createHelpCell("<i>", "images"))
+	table.SetCell(8, 0, createHelpCell("<v>", "volumes"))
+	table.SetCell(9, 0, createHelpCell("<n>", "networks"))
+	table.SetCell(10, 0, createHelpCell("<c>", "group by compose project"))
+	table.SetCell(11, 0, createHelpCell("<C-t>", "Restart container/project"))
+	table.SetCell(12, 0, createHelpCell("<C-p>", "Pause container"))
+	table.SetCell(13, 0, createHelpCell("<C-u>", "Unpause container"))
+	table.SetCell(14, 0, createHelpCell("<space>", "mark container"))
+	table.SetCell(15, 0, createHelpCell("<C-a>", "mark all / none"))
+	table.SetCell(16, 0, createHelpCell("<m>", "mark by filter"))
+	table.SetCell(17, 0, createHelpCell("<C-k>", "Kill container"))
+	table.SetCell(18, 0, createHelpCell("</>", "filter containers"))
+	table.SetCell(19, 0, createHelpCell("<H>", "healthcheck details"))
+	table.SetCell(20, 0, createHelpCell("<p>", "port bindings"))
+	table.SetCell(21, 0, createHelpCell("<r>", "run new container"))
+	table.SetCell(22, 0, createHelpCell("<e>", "edit container"))
+	table.SetCell(23, 0, createHelpCell("<f>", "browse files"))
+	table.SetCell(24, 0, createHelpCell("<u>", "disk usage"))
+	table.SetCell(25, 0, createHelpCell("<E>", "daemon events"))
+	table.SetCell(26, 0, createHelpCell("<x>", "switch Docker context"))
+	table.SetCell(27, 0, createHelpCell("<A>", "containers of all hosts"))
+	table.SetCell(28, 0, createHelpCell("<N>", "connect/disconnect network"))
 
 	// General
 	table.SetCell(1, 1, createHelpCell("<?>", "help"))
@@ -323,79 +507,198 @@ func createHelpCell(key string, helpText string) *tview.TableCell {
 	return tview.NewTableCell(fmt.Sprintf("[orange]%-20s[white]%s", key, helpText))
 }
 
+// refreshContainerTable lists the containers in the background and redraws
+// the table, unless ctx is done by then.
+func refreshContainerTable(ctx context.Context, table *tview.Table) {
+	go func() {
+		containers, hosts := listContainers()
+		app.QueueUpdateDraw(func() {
+			if ctx.Err() != nil {
+				return
+			}
+			containerModel.replace(containers)
+			containerModel.setHosts(hosts)
+			renderContainerTable(table, true)
+		})
+	}()
+}
+
+// updateFilteredContainers redraws the table from the container model right
+// away and lists the containers again in the background.
 func updateFilteredContainers(table *tview.Table) {
-	containers := dockerClient.GetContainers(showOnlyRunning)
+	renderContainerTable(table, false)
+	refreshContainerTable(containerStats.ctx, table)
+}
+
+// renderContainerTable redraws the table from the container model, applying
+// the running toggle, filter, grouping and sort order. Details are only
+// fetched again when fetchDetails is set or nothing is cached yet.
+func renderContainerTable(table *tview.Table, fetchDetails bool) {
+	selected := selectedReference(table)
+	selectedGroup, projectSelected := selectedProject(table)
+	table.Clear()
+	setTableHeaders(table, containerHeaderLabels())
+
+	visible := func(containers []types.Container) []types.Container {
+		var filtered []types.Container
+		for _, container := range containers {
+			if showOnlyRunning && container.State != "running" {
+				continue
+			}
+			if !matchesFilter(container, containerFilter) {
+				continue
+			}
+			filtered = append(filtered, container)
+		}
+		return filtered
+	}
 
-	var filteredContainers []types.Container
-	if showOnlyRunning {
+	currentRow := 1
+	var running []string
+	addRows := func(containers []types.Container) {
 		for _, container := range containers {
+			renderContainerRow(table, currentRow, container)
+			currentRow++
+
+			if _, cached := containerModel.getDetails(container.ID); fetchDetails || !cached {
+				fetchContainerDetails(table, container.ID)
+			}
 			if container.State == "running" {
-				filteredContainers = append(filteredContainers, container)
+				running = append(running, container.ID)
 			}
 		}
-	} else {
-		filteredContainers = containers
 	}
 
-	updateTableWithContainers(table, filteredContainers)
+	containers := sortContainers(containerModel.list())
+	if groupByProject {
+		for _, group := range groupContainersByProject(containers) {
+			projectContainers := visible(group.containers)
+			if group.project != "" {
+				if containerFilter != "" && len(projectContainers) == 0 {
+					continue
+				}
+				setProjectRow(table, currentRow, group)
+				currentRow++
+				if collapsedProjects[group.project] {
+					continue
+				}
+			}
+			addRows(projectContainers)
+		}
+	} else {
+		addRows(visible(containers))
+	}
+	containerStats.watch(running)
+	table.Select(0, 0)
+	if projectSelected {
+		selectProjectRow(table, selectedGroup)
+		return
+	}
+	selectContainerRow(table, selected)
 }
 
-func updateTableWithContainers(table *tview.Table, containers []types.Container) {
-	mapMutex.Lock()
-	defer mapMutex.Unlock()
+func selectContainerRow(table *tview.Table, containerID string) {
+	if row, exists := findContainerRow(table, containerID); exists {
+		table.Select(row, 0)
+	}
+}
 
-	containerMap = make(map[string]int)
-	table.Clear()
+func findContainerRow(table *tview.Table, containerID string) (int, bool) {
+	for row := 1; row < table.GetRowCount(); row++ {
+		if table.GetCell(row, 0).GetReference() == containerID {
+			return row, true
+		}
+	}
+	return 0, false
+}
 
-	headers := []string{"ID", "Container", "Image", "Uptime", "Status", "CPU / MEM"}
-	for i, header := range headers {
-		table.SetCell(0, i, tview.NewTableCell(fmt.Sprintf("[-:-:b]%s[-:-:B]", header)).
-			SetTextColor(tcell.GetColor(userTheme.Table.Headers)).
-			SetExpansion(1).
-			SetSelectable(false))
+func runningContainerIDs(ids []string) []string {
+	var running []string
+	for _, id := range ids {
+		if container, exists := containerModel.get(id); exists && container.State == "running" {
+			running = append(running, id)
+		}
 	}
+	return running
+}
 
-	currentRow := 1
-	for _, container := range containers {
-		if showOnlyRunning && container.State != "running" {
-			continue
+// renderContainerRow fills a row from the container model. The ID cell
+// references the full container ID, which is what every action targets.
+func renderContainerRow(table *tview.Table, row int, container types.Container) {
+	var uptime, usage string
+	var uptimeValue time.Duration
+	health := healthFromStatus(container.Status)
+	if details, cached := containerModel.getDetails(container.ID); cached {
+		uptimeValue = details.Uptime
+		uptime = details.Uptime.String()
+		if health == "" {
+			health = details.Health
 		}
+		usage = formatUsage(details.CPUUsage, details.MemoryUsage)
+	}
+	if stats, exists := containerModel.getStats(container.ID); exists {
+		usage = formatUsage(stats.CPUUsage, stats.MemoryUsage)
+	}
 
-		go func(container types.Container, row int) {
-			containerInfo, err := dockerClient.GetContainerInfo(container.ID)
-			if err != nil {
-				log.Printf("Error getting container info for %s: %v", container.ID, err)
-				return
-			}
+	table.SetCell(row, 0, tview.NewTableCell(shortID(container.ID)).SetReference(container.ID))
+	table.SetCell(row, 1, tview.NewTableCell(strings.TrimPrefix(container.Names[0], "/")))
+	table.SetCell(row, 2, tview.NewTableCell(container.Image))
+	table.SetCell(row, 3, tview.NewTableCell(uptime).SetReference(uptimeValue))
+	table.SetCell(row, 4, tview.NewTableCell(formatStatus(container.State)))
+	table.SetCell(row, 5, tview.NewTableCell(formatHealth(health)))
+	table.SetCell(row, 6, tview.NewTableCell(usage))
+	table.SetCell(row, 7, tview.NewTableCell(formatPorts(container.Ports)))
+	if host := containerModel.host(container.ID); aggregatedView && host != nil {
+		table.SetCell(row, 8, tview.NewTableCell(tview.Escape(host.name)))
+	}
+	applyMarkStyle(table, row)
+}
 
-			mapMutex.Lock()
-			containerMap[container.ID] = row
-			mapMutex.Unlock()
+// fetchContainerDetails inspects the container in the background and renders
+// its row again once the details are in the model.
+func fetchContainerDetails(table *tview.Table, containerID string) {
+	if !containerModel.startFetch(containerID) {
+		return
+	}
 
-			app.QueueUpdateDraw(func() {
-				updateContainerRow(table, row, containerInfo)
-			})
-		}(container, currentRow)
+	go func() {
+		defer containerModel.finishFetch(containerID)
 
-		currentRow++
-	}
-	table.Select(0, 0)
-}
+		containerInfo, err := clientFor(containerID).GetContainerInfo(containerID)
+		if err != nil {
+			log.Printf("Error getting container info for %s: %v", containerID, err)
+			return
+		}
+		containerModel.setDetails(containerID, containerInfo)
 
-func updateContainerRow(table *tview.Table, row int, containerInfo *docker.ContainerInfo) {
-	var status string
+		app.QueueUpdateDraw(func() {
+			if sortDependsOnDetails() {
+				renderContainerTable(table, false)
+				return
+			}
+			container, exists := containerModel.get(containerID)
+			row, shown := findContainerRow(table, containerID)
+			if exists && shown {
+				renderContainerRow(table, row, container)
+			}
+		})
+	}()
+}
 
-	switch containerInfo.State {
+func formatStatus(state string) string {
+	switch state {
 	case "running":
-		status = fmt.Sprintf("[green:black]%s[white:black]", containerInfo.State)
+		return fmt.Sprintf("[green:black]%s[white:black]", state)
+	case "paused":
+		return fmt.Sprintf("[yellow:black]%s[white:black]", state)
+	case "restarting":
+		return fmt.Sprintf("[orange:black]%s[white:black]", state)
+	case "created":
+		return fmt.Sprintf("[blue:black]%s[white:black]", state)
+	case "dead":
+		return fmt.Sprintf("[red:black]%s[white:black]", state)
 	case "exited":
-		status = fmt.Sprintf("[gray:black]%s[white:black]", containerInfo.State)
+		return fmt.Sprintf("[gray:black]%s[white:black]", state)
 	}
-
-	table.SetCell(row, 0, tview.NewTableCell(containerInfo.ID))
-	table.SetCell(row, 1, tview.NewTableCell(containerInfo.Name))
-	table.SetCell(row, 2, tview.NewTableCell(containerInfo.Image))
-	table.SetCell(row, 3, tview.NewTableCell(containerInfo.Uptime.String()))
-	table.SetCell(row, 4, tview.NewTableCell(status))
-	table.SetCell(row, 5, tview.NewTableCell(fmt.Sprintf("%.2f%% / %.2f MB", containerInfo.CPUUsage, containerInfo.MemoryUsage)))
+	return state
 }
@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"main/internal/docker"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/rivo/tview"
+)
+
+// daemonHost is a daemon whose containers are shown in the container table.
+// err is the error of the last listing, while the daemon is down.
+type daemonHost struct {
+	name   string
+	client *docker.DockerWrapper
+
+	mu  sync.Mutex
+	err error
+}
+
+// setError records the error of a listing and reports whether the daemon
+// went down or came back.
+func (h *daemonHost) setError(err error) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	changed := (h.err == nil) != (err == nil)
+	h.err = err
+	return changed
+}
+
+func (h *daemonHost) lastError() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.err
+}
+
+// containerListTimeout bounds listing the containers of a daemon, so a host
+// that went away only delays its own rows.
+const containerListTimeout = 5 * time.Second
+
+var (
+	// aggregatedView shows the containers of every known endpoint in one
+	// table, daemonHosts holds their connected clients.
+	aggregatedView bool
+	daemonHosts    []*daemonHost
+)
+
+// activeHosts returns the daemons shown in the container table, which is
+// only the current endpoint outside the aggregated view.
+func activeHosts() []*daemonHost {
+	if aggregatedView {
+		return daemonHosts
+	}
+	return []*daemonHost{{name: dockerClient.Endpoint().Name, client: &dockerClient}}
+}
+
+// clientFor returns the client of the daemon a container runs on, so actions,
+// logs and shells reach the right host in the aggregated view.
+func clientFor(containerID string) *docker.DockerWrapper {
+	if host := containerModel.host(containerID); host != nil {
+		return host.client
+	}
+	return &dockerClient
+}
+
+// listContainers lists the containers of every active daemon in parallel,
+// together with the daemon of every container. Daemons that can not be
+// reached are marked down and left out.
+func listContainers() ([]types.Container, map[string]*daemonHost) {
+	hosts := activeHosts()
+	listed := make([][]types.Container, len(hosts))
+
+	var wg sync.WaitGroup
+	for i, host := range hosts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), containerListTimeout)
+			defer cancel()
+			containers, err := host.client.ListContainers(ctx, true)
+			if err != nil {
+				log.Printf("Error listing containers of %s: %v", host.name, err)
+			}
+			listed[i] = containers
+			if host.setError(err) && aggregatedView {
+				go app.QueueUpdateDraw(refreshHelper)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var containers []types.Container
+	containerHosts := make(map[string]*daemonHost)
+	for i, hostContainers := range listed {
+		for _, container := range hostContainers {
+			containers = append(containers, container)
+			containerHosts[container.ID] = hosts[i]
+		}
+	}
+	return containers, containerHosts
+}
+
+func toggleAggregatedView() {
+	if aggregatedView {
+		closeAggregatedView()
+		cancelEventListener()
+		DrawHome()
+		NotificationInfo(fmt.Sprintf("Showing containers of %s", dockerClient.Endpoint().Name))
+		return
+	}
+
+	current := dockerClient.Endpoint()
+	var endpoints []docker.Endpoint
+	seenHosts := map[string]bool{current.Host: true}
+	for _, endpoint := range docker.LoadEndpoints(userConf.Hosts) {
+		// Endpoints for the same daemon would list every container twice.
+		if endpoint != current && !seenHosts[endpoint.Host] {
+			seenHosts[endpoint.Host] = true
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if len(endpoints) == 0 {
+		NotificationInfo("There are no other Docker contexts or hosts to aggregate")
+		return
+	}
+	NotificationInfo(fmt.Sprintf("Connecting to %d daemons...", len(endpoints)))
+
+	go func() {
+		hosts := make([]*daemonHost, len(endpoints))
+		errs := make([]error, len(endpoints))
+		var wg sync.WaitGroup
+		for i, endpoint := range endpoints {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				client := &docker.DockerWrapper{}
+				if errs[i] = client.Connect(endpoint); errs[i] == nil {
+					hosts[i] = &daemonHost{name: endpoint.Name, client: client}
+				}
+			}()
+		}
+		wg.Wait()
+
+		connected := []*daemonHost{{name: current.Name, client: &dockerClient}}
+		for _, host := range hosts {
+			if host != nil {
+				connected = append(connected, host)
+			}
+		}
+
+		app.QueueUpdateDraw(func() {
+			daemonHosts = connected
+			aggregatedView = true
+			cancelEventListener()
+			DrawHome()
+
+			if err := errors.Join(errs...); err != nil {
+				log.Printf("Error connecting to daemons: %v", err)
+				NotificationError(fmt.Errorf("showing %d daemons, %w", len(connected), err))
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Showing containers of %d daemons", len(connected)))
+		})
+	}()
+}
+
+// closeAggregatedView closes the clients of the aggregated view, except the
+// client of the current endpoint.
+func closeAggregatedView() {
+	for _, host := range daemonHosts {
+		if host.client != &dockerClient {
+			host.client.CloseClient()
+		}
+	}
+	daemonHosts = nil
+	aggregatedView = false
+}
+
+// formatHostStatus lists the daemons of the aggregated view with a marker
+// telling whether their containers could be listed the last time.
+func formatHostStatus(hosts []*daemonHost) string {
+	statuses := make([]string, len(hosts))
+	for i, host := range hosts {
+		marker := "[green]●[white]"
+		if host.lastError() != nil {
+			marker = "[red]●[white]"
+		}
+		statuses[i] = tview.Escape(host.name) + " " + marker
+	}
+	return strings.Join(statuses, "  ")
+}
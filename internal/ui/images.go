@@ -0,0 +1,175 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var imageHeaders = []string{"ID", "Repository", "Size", "Created", "Containers"}
+
+func DrawImages() {
+	table := createImageList()
+
+	flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(createNotification(), 3, 1, false).
+		AddItem(CreateFooterImages().TextView, 1, 1, false)
+
+	app.SetRoot(flex, true).SetFocus(table)
+}
+
+func createImageList() *tview.Table {
+	table := setupResourceTable("Images", imageHeaders)
+	updateImageTable(table)
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		return handleImageInput(event, table)
+	})
+
+	return table
+}
+
+func updateImageTable(table *tview.Table) {
+	images := dockerClient.GetImages()
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Created > images[j].Created
+	})
+
+	usage := make(map[string]int)
+	for _, container := range dockerClient.GetContainers(true) {
+		usage[container.ImageID]++
+	}
+
+	table.Clear()
+	setTableHeaders(table, imageHeaders)
+
+	for i, image := range images {
+		row := i + 1
+		repository := "<none>:<none>"
+		if len(image.RepoTags) > 0 {
+			repository = strings.Join(image.RepoTags, ", ")
+		}
+		created := units.HumanDuration(time.Since(time.Unix(image.Created, 0))) + " ago"
+
+		table.SetCell(row, 0, tview.NewTableCell(shortID(image.ID)).SetReference(image.ID))
+		table.SetCell(row, 1, tview.NewTableCell(repository))
+		table.SetCell(row, 2, tview.NewTableCell(units.HumanSize(float64(image.Size))))
+		table.SetCell(row, 3, tview.NewTableCell(created))
+		table.SetCell(row, 4, tview.NewTableCell(fmt.Sprintf("%d", usage[image.ID])))
+	}
+
+	if table.GetRowCount() > 1 {
+		table.Select(1, 0)
+	}
+}
+
+func handleImageInput(event *tcell.EventKey, table *tview.Table) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		DrawHome()
+		return nil
+	case tcell.KeyEnter:
+		showImageAttributes(table)
+		return nil
+	case tcell.KeyCtrlD:
+		showRemoveImageConfirmation(table, false)
+		return nil
+	case tcell.KeyCtrlF:
+		showRemoveImageConfirmation(table, true)
+		return nil
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'a':
+			showImageAttributes(table)
+			return nil
+		case 'c':
+			showImageContainers(table)
+			return nil
+		case 'r':
+			showRunImageForm(table)
+			return nil
+		}
+	}
+	return event
+}
+
+func showImageAttributes(table *tview.Table) {
+	imageID := selectedReference(table)
+	if imageID == "" {
+		return
+	}
+
+	attributes, err := highlightJSON(dockerClient.GetImageAttributes(imageID))
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+	drawTextScreen(shortID(imageID), attributes, DrawImages)
+}
+
+func showImageContainers(table *tview.Table) {
+	imageID := selectedReference(table)
+	if imageID == "" {
+		return
+	}
+
+	containers := dockerClient.GetImageContainers(imageID)
+	if len(containers) == 0 {
+		NotificationInfo(fmt.Sprintf("No containers are using %s", shortID(imageID)))
+		return
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[orange]%-14s%-40s%s[white]\n", "ID", "Container", "Status")
+	for _, container := range containers {
+		name := strings.TrimPrefix(container.Names[0], "/")
+		fmt.Fprintf(&sb, "%-14s%-40s%s\n", shortID(container.ID), name, container.Status)
+	}
+	drawTextScreen(fmt.Sprintf("Containers using %s", shortID(imageID)), sb.String(), DrawImages)
+}
+
+func showRunImageForm(table *tview.Table) {
+	imageID := selectedReference(table)
+	if imageID == "" {
+		return
+	}
+
+	row, _ := table.GetSelection()
+	image, _, _ := strings.Cut(table.GetCell(row, 1).Text, ",")
+	if image == "<none>:<none>" {
+		image = shortID(imageID)
+	}
+	showRunContainerForm(image, func() {
+		updateImageTable(table)
+	})
+}
+
+func showRemoveImageConfirmation(table *tview.Table, force bool) {
+	imageID := selectedReference(table)
+	if imageID == "" {
+		return
+	}
+
+	action, message := "REMOVE", "This will delete the image!"
+	if force {
+		action, message = "FORCE REMOVE", "This will delete the image even if it is in use!"
+	}
+
+	showConfirmationModal(action, shortID(imageID), message, func() {
+		err := dockerClient.RemoveImage(imageID, force)
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("Removed %s", shortID(imageID)))
+		app.QueueUpdateDraw(func() {
+			updateImageTable(table)
+		})
+	}, 60, 10)
+}
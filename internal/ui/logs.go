@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/docker/docker/api/types"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -18,8 +19,9 @@ func DrawLogs(table *tview.Table, containerID string) {
 	inputField := logSearcher.CreateInputField(table, containerID)
 	footer := CreateFooterLogs()
 
+	client := clientFor(containerID)
 	ctx, cancel := context.WithCancel(context.Background())
-	go dockerClient.ListenForNewLogs(ctx, containerID, app, textView, &ScrollOnNewLogEntry)
+	go client.ListenForNewLogs(ctx, containerID, app, textView, &ScrollOnNewLogEntry)
 
 	flex := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(textView, 0, 1, false).
@@ -27,6 +29,11 @@ func DrawLogs(table *tview.Table, containerID string) {
 
 	var isShellMode bool
 
+	// The attributes screen shows the structured details and the raw JSON of
+	// the inspect output as tabs, switched with Tab.
+	var attributes *types.ContainerJSON
+	var showRawAttributes bool
+
 	modal := func(p tview.Primitive, width, height int) tview.Primitive {
 		return tview.NewFlex().
 			AddItem(nil, 0, 1, false).
@@ -55,6 +62,13 @@ func DrawLogs(table *tview.Table, containerID string) {
 				AddItem(textView, 0, 1, false).
 				AddItem(footer.TextView, 1, 1, false)
 			app.SetFocus(inputField)
+		case tcell.KeyTab:
+			if attributes != nil {
+				showRawAttributes = !showRawAttributes
+				textView.SetText(formatAttributes(*attributes, showRawAttributes))
+				textView.ScrollToBeginning()
+			}
+			return nil
 		case tcell.KeyEscape:
 			logSearcher.Cleanup()
 			cancel()
@@ -82,15 +96,44 @@ func DrawLogs(table *tview.Table, containerID string) {
 		case 'a':
 			cancel()
 			textView.Clear()
-			textView.SetText(getAttributes(containerID))
+			info, err := client.InspectContainer(containerID)
+			if err != nil {
+				fmt.Fprintf(textView, "Error inspecting container: %v", err)
+				break
+			}
+			attributes, showRawAttributes = &info, false
+			textView.SetText(formatAttributes(info, false))
+			textView.ScrollToBeginning()
 		case 'e':
 			cancel()
+			attributes = nil
 			textView.Clear()
 			textView.SetText(getEnvironmentVariables(containerID))
+		case 'd':
+			cancel()
+			attributes = nil
+			tree := createDiffTree(containerID)
+			tree.SetDoneFunc(func(key tcell.Key) {
+				if key == tcell.KeyEscape {
+					logSearcher.Cleanup()
+					DrawHome()
+				}
+			})
+			flex.Clear()
+			flex.AddItem(tree, 0, 1, true).
+				AddItem(footer.TextView, 1, 1, false)
+			app.SetFocus(tree)
+			return nil
+		case 't':
+			cancel()
+			logSearcher.Cleanup()
+			DrawProcesses(table, containerID)
+			return nil
 		case 'v':
 			cancel()
+			attributes = nil
 			textView.Clear()
-			err := dockerClient.CreateContainerShell(context.Background(), containerID, textView)
+			err := client.CreateContainerShell(context.Background(), containerID, textView)
 			if err != nil {
 				fmt.Fprintf(textView, "Error creating shell: %v", err)
 			} else {
@@ -144,13 +187,26 @@ func createTextView() *tview.TextView {
 	return textView
 }
 
-func getAttributes(containerID string) string {
-	containerInfo, _ := highlightJSON(dockerClient.GetAttributes(containerID))
-	return containerInfo
+func formatAttributes(info types.ContainerJSON, raw bool) string {
+	details, rawJSON := "[black:orange:b] Details [-:-:-]", " Raw JSON "
+	if raw {
+		details, rawJSON = " Details ", "[black:orange:b] Raw JSON [-:-:-]"
+	}
+	header := fmt.Sprintf("%s %s  [gray]Tab to switch[white]\n\n", details, rawJSON)
+
+	if !raw {
+		return header + formatContainerDetails(info)
+	}
+	infoJSON, _ := json.MarshalIndent(info, "", "  ")
+	highlighted, err := highlightJSON(string(infoJSON))
+	if err != nil {
+		return header + err.Error()
+	}
+	return header + highlighted
 }
 
 func getEnvironmentVariables(containerID string) string {
-	environmentVariables, _ := highlightJSON(dockerClient.GetEnvironmentVariables(containerID))
+	environmentVariables, _ := highlightJSON(clientFor(containerID).GetEnvironmentVariables(containerID))
 	return environmentVariables
 }
 
@@ -222,8 +278,10 @@ func helpText() *tview.TextView {
 	[orange:-:b]Shortcuts[white:-:B] 
 	  [blue:-:b]ESC[white:-:B]   Back
 	  [blue:-:b]ENTER[white:-:B] Search
-	  [blue:-:b]A[white:-:B]	    Attributes
+	  [blue:-:b]A[white:-:B]	    Details (TAB for raw JSON)
 	  [blue:-:b]E[white:-:B]     Environment
+	  [blue:-:b]D[white:-:B]     Filesystem changes
+	  [blue:-:b]T[white:-:B]     Processes
 	  [blue:-:b]V[white:-:B]     Shell
 
 	[orange:-:b]Modes[white:-:B] 
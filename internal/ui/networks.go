@@ -0,0 +1,397 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types/network"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var networkHeaders = []string{"ID", "Name", "Driver", "Subnet", "Gateway", "Containers"}
+
+func DrawNetworks() {
+	table := createNetworkList()
+
+	flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(createNotification(), 3, 1, false).
+		AddItem(CreateFooterNetworks().TextView, 1, 1, false)
+
+	app.SetRoot(flex, true).SetFocus(table)
+}
+
+func createNetworkList() *tview.Table {
+	table := setupResourceTable("Networks", networkHeaders)
+	updateNetworkTable(table)
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		return handleNetworkInput(event, table)
+	})
+
+	return table
+}
+
+func updateNetworkTable(table *tview.Table) {
+	networks := dockerClient.GetNetworks()
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+
+	table.Clear()
+	setTableHeaders(table, networkHeaders)
+
+	for i, net := range networks {
+		row := i + 1
+		subnets, gateways := networkAddresses(net)
+
+		table.SetCell(row, 0, tview.NewTableCell(shortID(net.ID)).SetReference(net.ID))
+		table.SetCell(row, 1, tview.NewTableCell(net.Name))
+		table.SetCell(row, 2, tview.NewTableCell(net.Driver))
+		table.SetCell(row, 3, tview.NewTableCell(strings.Join(subnets, ", ")))
+		table.SetCell(row, 4, tview.NewTableCell(strings.Join(gateways, ", ")))
+	}
+
+	if table.GetRowCount() > 1 {
+		table.Select(1, 0)
+	}
+	showNetworkContainers(table)
+}
+
+// showNetworkContainers fills in the running containers attached to every
+// network in the background. They are taken from the container list, which
+// saves inspecting the networks one by one.
+func showNetworkContainers(table *tview.Table) {
+	go func() {
+		endpoints := make(map[string][]string)
+		for _, container := range dockerClient.GetContainers(true) {
+			if container.State != "running" || container.NetworkSettings == nil {
+				continue
+			}
+			name := strings.TrimPrefix(container.Names[0], "/")
+			for _, endpoint := range container.NetworkSettings.Networks {
+				if endpoint == nil {
+					continue
+				}
+				entry := name
+				if endpoint.IPAddress != "" {
+					entry = fmt.Sprintf("%s (%s/%d)", name, endpoint.IPAddress, endpoint.IPPrefixLen)
+				}
+				endpoints[endpoint.NetworkID] = append(endpoints[endpoint.NetworkID], entry)
+			}
+		}
+
+		app.QueueUpdateDraw(func() {
+			for row := 1; row < table.GetRowCount(); row++ {
+				networkID, _ := table.GetCell(row, 0).GetReference().(string)
+				attached := endpoints[networkID]
+				sort.Strings(attached)
+				table.SetCell(row, 5, tview.NewTableCell(strings.Join(attached, ", ")))
+			}
+		})
+	}()
+}
+
+func networkAddresses(net network.Summary) (subnets, gateways []string) {
+	for _, config := range net.IPAM.Config {
+		if config.Subnet != "" {
+			subnets = append(subnets, config.Subnet)
+		}
+		if config.Gateway != "" {
+			gateways = append(gateways, config.Gateway)
+		}
+	}
+	return subnets, gateways
+}
+
+func handleNetworkInput(event *tcell.EventKey, table *tview.Table) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		DrawHome()
+		return nil
+	case tcell.KeyEnter:
+		showNetworkDetails(table)
+		return nil
+	case tcell.KeyCtrlD:
+		showRemoveNetworkConfirmation(table)
+		return nil
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'a':
+			showNetworkAttributes(table)
+			return nil
+		case 'n':
+			showCreateNetworkForm(table)
+			return nil
+		case 'c':
+			showConnectContainerForm(table)
+			return nil
+		case 'x':
+			showDisconnectContainerForm(table)
+			return nil
+		}
+	}
+	return event
+}
+
+func showNetworkDetails(table *tview.Table) {
+	networkID := selectedReference(table)
+	if networkID == "" {
+		return
+	}
+
+	net, err := dockerClient.InspectNetwork(networkID)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+
+	subnets, gateways := networkAddresses(net)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[orange]%-12s[white]%s\n", "Name:", net.Name)
+	fmt.Fprintf(&sb, "[orange]%-12s[white]%s\n", "Driver:", net.Driver)
+	fmt.Fprintf(&sb, "[orange]%-12s[white]%s\n", "Scope:", net.Scope)
+	fmt.Fprintf(&sb, "[orange]%-12s[white]%t\n", "Internal:", net.Internal)
+	fmt.Fprintf(&sb, "[orange]%-12s[white]%s\n", "Subnet:", strings.Join(subnets, ", "))
+	fmt.Fprintf(&sb, "[orange]%-12s[white]%s\n\n", "Gateway:", strings.Join(gateways, ", "))
+
+	fmt.Fprintf(&sb, "[orange]%-14s%-40s%-20s%-26s%s[white]\n", "ID", "Container", "IPv4", "IPv6", "MAC")
+	for id, endpoint := range net.Containers {
+		fmt.Fprintf(&sb, "%-14s%-40s%-20s%-26s%s\n",
+			shortID(id), endpoint.Name, endpoint.IPv4Address, endpoint.IPv6Address, endpoint.MacAddress)
+	}
+
+	drawTextScreen(net.Name, sb.String(), DrawNetworks)
+}
+
+func showNetworkAttributes(table *tview.Table) {
+	networkID := selectedReference(table)
+	if networkID == "" {
+		return
+	}
+
+	attributes, err := highlightJSON(dockerClient.GetNetworkAttributes(networkID))
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+	drawTextScreen(shortID(networkID), attributes, DrawNetworks)
+}
+
+func showCreateNetworkForm(table *tview.Table) {
+	form := tview.NewForm().
+		AddInputField("Name", "", 40, nil, nil).
+		AddDropDown("Driver", []string{"bridge", "overlay", "macvlan", "ipvlan"}, 0, nil).
+		AddInputField("Subnet", "", 40, nil, nil).
+		AddInputField("Gateway", "", 40, nil, nil)
+
+	form.AddButton("Create", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		_, driver := form.GetFormItemByLabel("Driver").(*tview.DropDown).GetCurrentOption()
+		subnet := form.GetFormItemByLabel("Subnet").(*tview.InputField).GetText()
+		gateway := form.GetFormItemByLabel("Gateway").(*tview.InputField).GetText()
+
+		closeModal()
+		go func() {
+			_, err := dockerClient.CreateNetwork(name, driver, subnet, gateway)
+			if err != nil {
+				NotificationError(err)
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Created network %s", name))
+			app.QueueUpdateDraw(func() {
+				updateNetworkTable(table)
+			})
+		}()
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Create network", form, 60, 13)
+}
+
+func showRemoveNetworkConfirmation(table *tview.Table) {
+	networkID := selectedReference(table)
+	if networkID == "" {
+		return
+	}
+
+	showConfirmationModal("REMOVE", shortID(networkID), "This will delete the network!", func() {
+		err := dockerClient.RemoveNetwork(networkID)
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("Removed %s", shortID(networkID)))
+		app.QueueUpdateDraw(func() {
+			updateNetworkTable(table)
+		})
+	}, 60, 10)
+}
+
+func showConnectContainerForm(table *tview.Table) {
+	networkID := selectedReference(table)
+	if networkID == "" {
+		return
+	}
+
+	net, err := dockerClient.InspectNetwork(networkID)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+
+	var ids, names []string
+	for _, container := range dockerClient.GetContainers(true) {
+		if _, attached := net.Containers[container.ID]; attached {
+			continue
+		}
+		ids = append(ids, container.ID)
+		names = append(names, strings.TrimPrefix(container.Names[0], "/"))
+	}
+	if len(ids) == 0 {
+		NotificationInfo(fmt.Sprintf("All containers are already connected to %s", net.Name))
+		return
+	}
+
+	showNetworkContainerForm("Connect to "+net.Name, "Connect", names, func(index int) {
+		err := dockerClient.ConnectContainer(networkID, ids[index])
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("Connected %s to %s", names[index], net.Name))
+		app.QueueUpdateDraw(func() {
+			updateNetworkTable(table)
+		})
+	})
+}
+
+func showDisconnectContainerForm(table *tview.Table) {
+	networkID := selectedReference(table)
+	if networkID == "" {
+		return
+	}
+
+	net, err := dockerClient.InspectNetwork(networkID)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+
+	var ids, names []string
+	for id, endpoint := range net.Containers {
+		ids = append(ids, id)
+		names = append(names, endpoint.Name)
+	}
+	if len(ids) == 0 {
+		NotificationInfo(fmt.Sprintf("No containers are connected to %s", net.Name))
+		return
+	}
+
+	showNetworkContainerForm("Disconnect from "+net.Name, "Disconnect", names, func(index int) {
+		err := dockerClient.DisconnectContainer(networkID, ids[index])
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("Disconnected %s from %s", names[index], net.Name))
+		app.QueueUpdateDraw(func() {
+			updateNetworkTable(table)
+		})
+	})
+}
+
+func showNetworkContainerForm(title, action string, names []string, onSubmit func(index int)) {
+	form := tview.NewForm().
+		AddDropDown("Container", names, 0, nil)
+
+	form.AddButton(action, func() {
+		index, _ := form.GetFormItemByLabel("Container").(*tview.DropDown).GetCurrentOption()
+		closeModal()
+		go onSubmit(index)
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal(title, form, 60, 7)
+}
+
+// showContainerNetworksForm connects the selected container of the container
+// table to a network, or disconnects it from one it is attached to.
+func showContainerNetworksForm(table *tview.Table) {
+	containerID := selectedReference(table)
+	if containerID == "" {
+		return
+	}
+	client := clientFor(containerID)
+
+	info, err := client.InspectContainer(containerID)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+	name := strings.TrimPrefix(info.Name, "/")
+	var attachedNetworks map[string]*network.EndpointSettings
+	if info.NetworkSettings != nil {
+		attachedNetworks = info.NetworkSettings.Networks
+	}
+
+	networks := client.GetNetworks()
+	if len(networks) == 0 {
+		NotificationInfo("There are no networks")
+		return
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+	options := make([]string, len(networks))
+	for i, net := range networks {
+		options[i] = net.Name
+		if _, attached := attachedNetworks[net.Name]; attached {
+			options[i] += " (connected)"
+		}
+	}
+
+	form := tview.NewForm().
+		AddDropDown("Network", options, 0, nil)
+
+	submit := func(connect bool) {
+		index, _ := form.GetFormItemByLabel("Network").(*tview.DropDown).GetCurrentOption()
+		net := networks[index]
+		_, attached := attachedNetworks[net.Name]
+		if connect == attached {
+			if attached {
+				NotificationInfo(fmt.Sprintf("%s is already connected to %s", name, net.Name))
+			} else {
+				NotificationInfo(fmt.Sprintf("%s is not connected to %s", name, net.Name))
+			}
+			return
+		}
+		closeModal()
+
+		go func() {
+			var err error
+			if connect {
+				err = client.ConnectContainer(net.ID, containerID)
+			} else {
+				err = client.DisconnectContainer(net.ID, containerID)
+			}
+			if err != nil {
+				NotificationError(err)
+				return
+			}
+			if connect {
+				NotificationSuccess(fmt.Sprintf("Connected %s to %s", name, net.Name))
+			} else {
+				NotificationSuccess(fmt.Sprintf("Disconnected %s from %s", name, net.Name))
+			}
+		}()
+	}
+	form.AddButton("Connect", func() { submit(true) })
+	form.AddButton("Disconnect", func() { submit(false) })
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Networks of "+name, form, 60, 7)
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/go-connections/nat"
+	"github.com/rivo/tview"
+)
+
+// maxListedPorts is the number of ports shown in the ports column before the
+// rest is collapsed into a count.
+const maxListedPorts = 2
+
+// formatPorts renders the ports of a container list entry as
+// host:container/proto, listing a port published on both IPv4 and IPv6 once.
+func formatPorts(ports []types.Port) string {
+	sorted := make([]types.Port, len(ports))
+	copy(sorted, ports)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].PrivatePort != sorted[j].PrivatePort {
+			return sorted[i].PrivatePort < sorted[j].PrivatePort
+		}
+		return sorted[i].PublicPort < sorted[j].PublicPort
+	})
+
+	seen := make(map[string]bool)
+	var formatted []string
+	for _, port := range sorted {
+		text := fmt.Sprintf("%d/%s", port.PrivatePort, port.Type)
+		if port.PublicPort != 0 {
+			text = fmt.Sprintf("%d:%s", port.PublicPort, text)
+		}
+		if seen[text] {
+			continue
+		}
+		seen[text] = true
+		formatted = append(formatted, text)
+	}
+
+	if len(formatted) > maxListedPorts {
+		return fmt.Sprintf("%s [gray]+%d[white]", strings.Join(formatted[:maxListedPorts], ", "), len(formatted)-maxListedPorts)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func showPortDetails(table *tview.Table) {
+	containerID := selectedReference(table)
+	if containerID == "" {
+		return
+	}
+
+	info, err := clientFor(containerID).GetContainerPorts(containerID)
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+	if len(info.Ports) == 0 {
+		NotificationInfo(fmt.Sprintf("%s has no exposed or published ports", info.Name))
+		return
+	}
+
+	ports := make([]nat.Port, 0, len(info.Ports))
+	for port := range info.Ports {
+		ports = append(ports, port)
+	}
+	nat.Sort(ports, func(i, j nat.Port) bool {
+		if i.Int() != j.Int() {
+			return i.Int() < j.Int()
+		}
+		return i.Proto() < j.Proto()
+	})
+
+	var published, unpublished []nat.Port
+	for _, port := range ports {
+		if len(info.Ports[port]) > 0 {
+			published = append(published, port)
+		} else {
+			unpublished = append(unpublished, port)
+		}
+	}
+
+	networks := make([]string, 0, len(info.Networks))
+	for name := range info.Networks {
+		networks = append(networks, name)
+	}
+	sort.Strings(networks)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[orange::b]Published[white::B]\n")
+	if len(published) == 0 {
+		fmt.Fprintf(&sb, "  [gray]none[white]\n")
+	}
+	for _, port := range published {
+		var hosts []string
+		for _, binding := range info.Ports[port] {
+			hosts = append(hosts, formatHostBinding(binding))
+		}
+		fmt.Fprintf(&sb, "  [orange]%-12s[white] -> %s\n", port, strings.Join(hosts, ", "))
+	}
+
+	for _, name := range networks {
+		ip := info.Networks[name]
+		fmt.Fprintf(&sb, "\n[orange::b]Network %s[white::B]", tview.Escape(name))
+		if ip == "" {
+			fmt.Fprintf(&sb, " [gray](no address)[white]\n")
+			continue
+		}
+		fmt.Fprintf(&sb, " (%s)\n", ip)
+		for _, port := range ports {
+			address := net.JoinHostPort(ip, port.Port()) + "/" + port.Proto()
+			if len(info.Ports[port]) > 0 {
+				fmt.Fprintf(&sb, "  %-28s [green]published[white]\n", address)
+			} else {
+				fmt.Fprintf(&sb, "  %-28s [gray]container only[white]\n", address)
+			}
+		}
+	}
+
+	fmt.Fprintf(&sb, "\n[orange::b]Exposed but not published[white::B]\n")
+	if len(unpublished) == 0 {
+		fmt.Fprintf(&sb, "  [gray]none[white]\n")
+	}
+	for _, port := range unpublished {
+		fmt.Fprintf(&sb, "  %s\n", port)
+	}
+
+	cancelEventListener()
+	drawTextScreen(fmt.Sprintf("Ports of %s", info.Name), sb.String(), DrawHome)
+}
+
+func formatHostBinding(binding nat.PortBinding) string {
+	hostIP := binding.HostIP
+	if hostIP == "" {
+		hostIP = "0.0.0.0"
+	}
+	return net.JoinHostPort(hostIP, binding.HostPort)
+}
@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"main/internal/docker"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var processHeaders = []string{"PID", "Host PID", "User", "CPU %", "MEM %", "Command"}
+
+var (
+	processSort           = 1
+	processSortDescending bool
+)
+
+// DrawProcesses shows the processes of a container, refreshed at the stats
+// interval. ESC returns to the logs of the container.
+func DrawProcesses(containerTable *tview.Table, containerID string) {
+	table := setupResourceTable(fmt.Sprintf("Processes of %s", containerModel.name(containerID)), processHeaders)
+	table.SetBorder(true)
+
+	flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(createNotification(), 3, 1, false).
+		AddItem(CreateFooterProcesses().TextView, 1, 1, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var processes []docker.Process
+
+	refresh := func() {
+		updated, err := clientFor(containerID).GetProcesses(containerID)
+		if ctx.Err() != nil {
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				NotificationError(err)
+				return
+			}
+			processes = updated
+			renderProcessTable(table, processes)
+		})
+	}
+
+	go func() {
+		ticker := time.NewTicker(statsInterval())
+		defer ticker.Stop()
+		refresh()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				refresh()
+			}
+		}
+	}()
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			cancel()
+			DrawLogs(containerTable, containerID)
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 's':
+				processSort = (processSort + 1) % len(processHeaders)
+				renderProcessTable(table, processes)
+				return nil
+			case 'S':
+				processSortDescending = !processSortDescending
+				renderProcessTable(table, processes)
+				return nil
+			case 'k':
+				if process, ok := selectedProcess(table); ok {
+					showSignalForm(containerID, process)
+				}
+				return nil
+			}
+		}
+		return event
+	})
+
+	app.SetRoot(flex, true).SetFocus(table)
+}
+
+func renderProcessTable(table *tview.Table, processes []docker.Process) {
+	selected := ""
+	if process, ok := selectedProcess(table); ok {
+		selected = process.HostPID
+	}
+
+	sorted := make([]docker.Process, len(processes))
+	copy(sorted, processes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if processSortDescending {
+			return lessProcess(sorted[j], sorted[i])
+		}
+		return lessProcess(sorted[i], sorted[j])
+	})
+
+	labels := make([]string, len(processHeaders))
+	copy(labels, processHeaders)
+	if processSortDescending {
+		labels[processSort] += " ▼"
+	} else {
+		labels[processSort] += " ▲"
+	}
+
+	table.Clear()
+	setTableHeaders(table, labels)
+	for i, process := range sorted {
+		row := i + 1
+		pid := "[gray]-[white]"
+		if process.Container.PID != "" {
+			pid = process.Container.PID
+		}
+		table.SetCell(row, 0, tview.NewTableCell(pid).SetReference(process))
+		table.SetCell(row, 1, tview.NewTableCell(process.HostPID))
+		table.SetCell(row, 2, tview.NewTableCell(tview.Escape(process.User)))
+		table.SetCell(row, 3, tview.NewTableCell(strconv.FormatFloat(process.CPU, 'f', 1, 64)))
+		table.SetCell(row, 4, tview.NewTableCell(strconv.FormatFloat(process.Memory, 'f', 1, 64)))
+		table.SetCell(row, 5, tview.NewTableCell(tview.Escape(process.Command)))
+
+		if process.HostPID == selected {
+			table.Select(row, 0)
+		}
+	}
+	if row, _ := table.GetSelection(); row < 1 && table.GetRowCount() > 1 {
+		table.Select(1, 0)
+	}
+}
+
+func lessProcess(a, b docker.Process) bool {
+	number := func(value string) int {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return -1
+		}
+		return n
+	}
+
+	switch processSort {
+	case 0:
+		return number(a.Container.PID) < number(b.Container.PID)
+	case 1:
+		return number(a.HostPID) < number(b.HostPID)
+	case 2:
+		return a.User < b.User
+	case 3:
+		return a.CPU < b.CPU
+	case 4:
+		return a.Memory < b.Memory
+	default:
+		return strings.ToLower(a.Command) < strings.ToLower(b.Command)
+	}
+}
+
+func selectedProcess(table *tview.Table) (docker.Process, bool) {
+	row, _ := table.GetSelection()
+	if row < 1 || row >= table.GetRowCount() {
+		return docker.Process{}, false
+	}
+	process, ok := table.GetCell(row, 0).GetReference().(docker.Process)
+	return process, ok
+}
+
+// showSignalForm asks which signal to send to a process. Processes that could
+// not be found in the container can not be signalled.
+func showSignalForm(containerID string, process docker.Process) {
+	if process.Container.PID == "" {
+		NotificationError(fmt.Errorf("host process %s could not be found in the container", process.HostPID))
+		return
+	}
+
+	form := tview.NewForm().
+		AddDropDown("Signal", killSignals, 0, nil)
+
+	form.AddButton("Send", func() {
+		_, signal := form.GetFormItemByLabel("Signal").(*tview.DropDown).GetCurrentOption()
+		closeModal()
+		subject := fmt.Sprintf("PID %s (%s)", process.Container.PID, tview.Escape(process.Command))
+		showConfirmationModal("SEND "+signal+" TO", subject, "", func() {
+			if err := clientFor(containerID).SignalProcess(containerID, process.Container, signal); err != nil {
+				NotificationError(err)
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Sent %s to PID %s", signal, process.Container.PID))
+		}, 70, 10)
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Signal process", form, 60, 7)
+}
@@ -0,0 +1,259 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"main/internal/docker"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
+	"github.com/rivo/tview"
+)
+
+// showRunContainerForm asks for the options of a new container, shows the
+// equivalent docker run command for confirmation and then runs it. onRun is
+// called after the container was started.
+func showRunContainerForm(image string, onRun func()) {
+	networks := []string{"bridge"}
+	for _, network := range dockerClient.GetNetworks() {
+		if network.Name != "bridge" {
+			networks = append(networks, network.Name)
+		}
+	}
+	sort.Strings(networks[1:])
+
+	form := tview.NewForm().
+		AddInputField("Image", image, 50, nil, nil).
+		AddInputField("Name", "", 50, nil, nil).
+		AddInputField("Command", "", 50, nil, nil).
+		AddInputField("Env (KEY=VALUE; ...)", "", 50, nil, nil).
+		AddInputField("Ports (8080:80/tcp, ...)", "", 50, nil, nil).
+		AddInputField("Volumes (src:dst[:ro], ...)", "", 50, nil, nil).
+		AddDropDown("Network", networks, 0, nil).
+		AddInputField("Restart policy", "no", 50, nil, nil).
+		AddInputField("CPUs", "", 10, nil, nil).
+		AddInputField("Memory (e.g. 512m)", "", 10, nil, nil)
+
+	form.AddButton("Review", func() {
+		options, err := runOptionsFromForm(form)
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+
+		closeModal()
+		command := formatRunCommand(options)
+		showConfirmationModal("RUN", options.Image, "\n[gray]"+tview.Escape(command), func() {
+			id, err := dockerClient.RunContainer(options, func() {
+				NotificationInfo(fmt.Sprintf("Pulling %s...", options.Image))
+			})
+			if err != nil {
+				NotificationError(err)
+				return
+			}
+
+			name := options.Name
+			if name == "" {
+				name = shortID(id)
+			}
+			NotificationSuccess(fmt.Sprintf("Started %s from %s", name, options.Image))
+			if onRun != nil {
+				app.QueueUpdateDraw(onRun)
+			}
+		}, 80, min(12+len(command)/70, 20))
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Run container", form, 80, 25)
+}
+
+func runOptionsFromForm(form *tview.Form) (docker.RunOptions, error) {
+	text := func(label string) string {
+		return strings.TrimSpace(form.GetFormItemByLabel(label).(*tview.InputField).GetText())
+	}
+
+	options := docker.RunOptions{
+		Image:   text("Image"),
+		Name:    text("Name"),
+		Ports:   splitList(text("Ports (8080:80/tcp, ...)")),
+		Binds:   splitList(text("Volumes (src:dst[:ro], ...)")),
+		Restart: text("Restart policy"),
+	}
+	if options.Image == "" {
+		return options, errors.New("an image is required")
+	}
+	_, options.Network = form.GetFormItemByLabel("Network").(*tview.DropDown).GetCurrentOption()
+
+	cmd, err := splitCommand(text("Command"))
+	if err != nil {
+		return options, err
+	}
+	options.Cmd = cmd
+
+	if options.Env, err = parseEnv(text("Env (KEY=VALUE; ...)")); err != nil {
+		return options, err
+	}
+
+	if _, _, err := nat.ParsePortSpecs(options.Ports); err != nil {
+		return options, err
+	}
+	for _, bind := range options.Binds {
+		parts := strings.Split(bind, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || !path.IsAbs(parts[1]) {
+			return options, fmt.Errorf("invalid volume %q: expected source:/container/path[:ro]", bind)
+		}
+	}
+	if _, err := docker.ParseRestartPolicy(options.Restart); err != nil {
+		return options, err
+	}
+
+	if options.NanoCPUs, err = parseCPUs(text("CPUs")); err != nil {
+		return options, err
+	}
+	if options.Memory, err = parseMemory(text("Memory (e.g. 512m)")); err != nil {
+		return options, err
+	}
+	return options, nil
+}
+
+// parseEnv parses environment variables separated by semicolons, so values
+// may contain commas, e.g. JAVA_OPTS=-Xms1g,-Xmx2g. Values are kept as
+// entered.
+func parseEnv(input string) ([]string, error) {
+	var env []string
+	for _, entry := range strings.Split(input, ";") {
+		entry = strings.TrimLeft(entry, " ")
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		key, _, found := strings.Cut(entry, "=")
+		if !found || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("invalid environment variable %q: expected KEY=VALUE", entry)
+		}
+		env = append(env, entry)
+	}
+	return env, nil
+}
+
+func parseCPUs(input string) (int64, error) {
+	if input == "" {
+		return 0, nil
+	}
+	cpus, err := strconv.ParseFloat(input, 64)
+	if err != nil || cpus <= 0 {
+		return 0, fmt.Errorf("invalid CPUs %q: expected a positive number like 1.5", input)
+	}
+	return int64(cpus * 1e9), nil
+}
+
+func parseMemory(input string) (int64, error) {
+	if input == "" {
+		return 0, nil
+	}
+	memory, err := units.RAMInBytes(input)
+	if err != nil || memory <= 0 {
+		return 0, fmt.Errorf("invalid memory %q: expected a size like 512m or 2g", input)
+	}
+	return memory, nil
+}
+
+// formatRunCommand returns the docker run command line that creates the same
+// container as options.
+func formatRunCommand(options docker.RunOptions) string {
+	args := []string{"docker", "run", "-d"}
+	if options.Name != "" {
+		args = append(args, "--name", options.Name)
+	}
+	for _, env := range options.Env {
+		args = append(args, "-e", env)
+	}
+	for _, port := range options.Ports {
+		args = append(args, "-p", port)
+	}
+	for _, bind := range options.Binds {
+		args = append(args, "-v", bind)
+	}
+	if options.Network != "" && options.Network != "bridge" {
+		args = append(args, "--network", options.Network)
+	}
+	if options.Restart != "" && options.Restart != "no" {
+		args = append(args, "--restart", options.Restart)
+	}
+	if options.NanoCPUs > 0 {
+		args = append(args, "--cpus", strconv.FormatFloat(float64(options.NanoCPUs)/1e9, 'f', -1, 64))
+	}
+	if options.Memory > 0 {
+		memory := strconv.FormatInt(options.Memory, 10)
+		if options.Memory%units.MiB == 0 {
+			memory = strconv.FormatInt(options.Memory/units.MiB, 10) + "m"
+		}
+		args = append(args, "--memory", memory)
+	}
+	args = append(args, options.Image)
+	args = append(args, options.Cmd...)
+
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`!*?;&|<>()#~") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// splitCommand splits a command line into arguments, honoring single and
+// double quotes.
+func splitCommand(input string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg := false
+
+	for _, r := range input {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in command", quote)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+func splitList(input string) []string {
+	var items []string
+	for _, item := range strings.Split(input, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"main/internal/docker"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/rivo/tview"
+)
+
+type sortColumn int
+
+const (
+	sortNone sortColumn = iota
+	sortByName
+	sortByImage
+	sortByUptime
+	sortByStatus
+	sortByCPU
+	sortByMemory
+)
+
+var (
+	currentSort    = sortNone
+	sortDescending bool
+	sortNames      = []string{"none", "name", "image", "uptime", "status", "cpu", "memory"}
+)
+
+func cycleSortColumn(table *tview.Table) {
+	currentSort = (currentSort + 1) % sortColumn(len(sortNames))
+	applySortChange(table)
+}
+
+func toggleSortDirection(table *tview.Table) {
+	sortDescending = !sortDescending
+	applySortChange(table)
+}
+
+func applySortChange(table *tview.Table) {
+	renderContainerTable(table, false)
+
+	direction := "ascending"
+	if sortDescending {
+		direction = "descending"
+	}
+	NotificationInfo(fmt.Sprintf("Sorting by %s (%s)", sortNames[currentSort], direction))
+}
+
+// containerHeaderLabels returns the container table headers with an indicator
+// on the column the table is sorted by.
+func containerHeaderLabels() []string {
+	labels := make([]string, len(containerHeaders))
+	copy(labels, containerHeaders)
+
+	indicator := "▲"
+	if sortDescending {
+		indicator = "▼"
+	}
+
+	switch currentSort {
+	case sortByName:
+		labels[1] += " " + indicator
+	case sortByImage:
+		labels[2] += " " + indicator
+	case sortByUptime:
+		labels[3] += " " + indicator
+	case sortByStatus:
+		labels[4] += " " + indicator
+	case sortByCPU:
+		labels[6] = strings.Replace(labels[6], "CPU", "CPU "+indicator, 1)
+	case sortByMemory:
+		labels[6] = strings.Replace(labels[6], "MEM", "MEM "+indicator, 1)
+	}
+	if aggregatedView {
+		labels = append(labels, "Host")
+	}
+	return labels
+}
+
+// sortDependsOnDetails reports whether the sort order uses values that arrive
+// after the container list, so the table has to be redrawn when they change.
+func sortDependsOnDetails() bool {
+	switch currentSort {
+	case sortByUptime, sortByCPU, sortByMemory:
+		return true
+	}
+	return false
+}
+
+// sortContainers sorts the containers in place by the current sort column,
+// using the details and stats in the container model.
+func sortContainers(containers []types.Container) []types.Container {
+	if currentSort == sortNone {
+		return containers
+	}
+
+	sort.SliceStable(containers, func(i, j int) bool {
+		if sortDescending {
+			return lessContainer(containers[j], containers[i])
+		}
+		return lessContainer(containers[i], containers[j])
+	})
+	return containers
+}
+
+func lessContainer(a, b types.Container) bool {
+	switch currentSort {
+	case sortByImage:
+		if a.Image != b.Image {
+			return a.Image < b.Image
+		}
+	case sortByUptime:
+		uptimeA, uptimeB := containerUptime(a.ID), containerUptime(b.ID)
+		if uptimeA != uptimeB {
+			return uptimeA < uptimeB
+		}
+	case sortByStatus:
+		if a.State != b.State {
+			return a.State < b.State
+		}
+	case sortByCPU:
+		usageA, usageB := containerUsage(a.ID), containerUsage(b.ID)
+		if usageA.CPUUsage != usageB.CPUUsage {
+			return usageA.CPUUsage < usageB.CPUUsage
+		}
+	case sortByMemory:
+		usageA, usageB := containerUsage(a.ID), containerUsage(b.ID)
+		if usageA.MemoryUsage != usageB.MemoryUsage {
+			return usageA.MemoryUsage < usageB.MemoryUsage
+		}
+	}
+	return a.Names[0] < b.Names[0]
+}
+
+func containerUptime(id string) time.Duration {
+	if details, cached := containerModel.getDetails(id); cached {
+		return details.Uptime
+	}
+	return 0
+}
+
+func containerUsage(id string) docker.ContainerStats {
+	if stats, exists := containerModel.getStats(id); exists {
+		return stats
+	}
+	if details, cached := containerModel.getDetails(id); cached {
+		return docker.ContainerStats{ID: id, CPUUsage: details.CPUUsage, MemoryUsage: details.MemoryUsage}
+	}
+	return docker.ContainerStats{ID: id}
+}
@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"main/internal/docker"
+	"sync"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+const defaultStatsInterval = 2 * time.Second
+
+// statsMonitor keeps a stats stream open for every running container shown in
+// the table, stores the samples in the container model and periodically
+// writes them into the CPU / MEM column.
+type statsMonitor struct {
+	ctx           context.Context
+	table         *tview.Table
+	statsChan     chan docker.ContainerStats
+	subscriptions map[string]context.CancelFunc
+	mu            sync.Mutex
+}
+
+var containerStats *statsMonitor
+
+func newStatsMonitor(ctx context.Context, table *tview.Table) *statsMonitor {
+	monitor := &statsMonitor{
+		ctx:           ctx,
+		table:         table,
+		statsChan:     make(chan docker.ContainerStats, 100),
+		subscriptions: make(map[string]context.CancelFunc),
+	}
+	go monitor.run()
+	return monitor
+}
+
+// watch subscribes to the given container IDs and drops subscriptions for
+// containers that are no longer in the list.
+func (m *statsMonitor) watch(ids []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	wanted := make(map[string]bool)
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	for id, cancel := range m.subscriptions {
+		if !wanted[id] {
+			cancel()
+			delete(m.subscriptions, id)
+			containerModel.clearStats(id)
+		}
+	}
+
+	for id := range wanted {
+		if _, exists := m.subscriptions[id]; exists {
+			continue
+		}
+		ctx, cancel := context.WithCancel(m.ctx)
+		m.subscriptions[id] = cancel
+		go m.stream(ctx, cancel, id)
+	}
+}
+
+// stream streams the stats of a container. When the stream ends by itself,
+// e.g. because the daemon restarted, the subscription is dropped so the next
+// watch subscribes again.
+func (m *statsMonitor) stream(ctx context.Context, cancel context.CancelFunc, id string) {
+	clientFor(id).StreamContainerStats(ctx, id, m.statsChan)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if ctx.Err() == nil {
+		delete(m.subscriptions, id)
+	}
+	cancel()
+}
+
+func (m *statsMonitor) run() {
+	ticker := time.NewTicker(statsInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case stats := <-m.statsChan:
+			m.mu.Lock()
+			if _, exists := m.subscriptions[stats.ID]; exists {
+				containerModel.setStats(stats)
+			}
+			m.mu.Unlock()
+		case <-ticker.C:
+			app.QueueUpdateDraw(m.render)
+		}
+	}
+}
+
+func (m *statsMonitor) render() {
+	if currentSort == sortByCPU || currentSort == sortByMemory {
+		renderContainerTable(m.table, false)
+		return
+	}
+
+	for row := 1; row < m.table.GetRowCount(); row++ {
+		containerID, ok := m.table.GetCell(row, 0).GetReference().(string)
+		if !ok {
+			continue
+		}
+		if stats, exists := containerModel.getStats(containerID); exists {
+			m.table.GetCell(row, 6).SetText(formatUsage(stats.CPUUsage, stats.MemoryUsage))
+		}
+	}
+}
+
+func formatUsage(cpuUsage, memoryUsage float64) string {
+	return fmt.Sprintf("%.2f%% / %.2f MB", cpuUsage, memoryUsage)
+}
+
+func statsInterval() time.Duration {
+	if userConf.StatsInterval > 0 {
+		return time.Duration(userConf.StatsInterval) * time.Second
+	}
+	return defaultStatsInterval
+}
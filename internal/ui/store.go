@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"main/internal/docker"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+)
+
+// containerStore is the model the container table renders from. Entries are
+// keyed by the full container ID and kept up to date from container list
+// calls, the event stream, inspect details and stats samples.
+type containerStore struct {
+	mu         sync.Mutex
+	containers map[string]types.Container
+	details    map[string]*docker.ContainerInfo
+	stats      map[string]docker.ContainerStats
+	fetching   map[string]bool
+	hosts      map[string]*daemonHost
+}
+
+var containerModel = newContainerStore()
+
+func newContainerStore() *containerStore {
+	return &containerStore{
+		containers: make(map[string]types.Container),
+		details:    make(map[string]*docker.ContainerInfo),
+		stats:      make(map[string]docker.ContainerStats),
+		fetching:   make(map[string]bool),
+		hosts:      make(map[string]*daemonHost),
+	}
+}
+
+// replace sets the containers to the result of a list call, dropping anything
+// known about containers that are no longer listed.
+func (s *containerStore) replace(containers []types.Container) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	listed := make(map[string]types.Container, len(containers))
+	for _, container := range containers {
+		listed[container.ID] = container
+	}
+	for id := range s.containers {
+		if _, exists := listed[id]; !exists {
+			delete(s.details, id)
+			delete(s.stats, id)
+		}
+	}
+	s.containers = listed
+}
+
+// setHosts sets the daemon of every listed container.
+func (s *containerStore) setHosts(hosts map[string]*daemonHost) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hosts = hosts
+}
+
+func (s *containerStore) host(id string) *daemonHost {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.hosts[id]
+}
+
+func (s *containerStore) remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.containers, id)
+	delete(s.details, id)
+	delete(s.stats, id)
+}
+
+func (s *containerStore) get(id string) (types.Container, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	container, exists := s.containers[id]
+	return container, exists
+}
+
+// list returns all containers, newest first like the Docker CLI.
+func (s *containerStore) list() []types.Container {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	containers := make([]types.Container, 0, len(s.containers))
+	for _, container := range s.containers {
+		containers = append(containers, container)
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		if containers[i].Created != containers[j].Created {
+			return containers[i].Created > containers[j].Created
+		}
+		return containers[i].ID < containers[j].ID
+	})
+	return containers
+}
+
+func (s *containerStore) name(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if container, exists := s.containers[id]; exists && len(container.Names) > 0 {
+		return strings.TrimPrefix(container.Names[0], "/")
+	}
+	return shortID(id)
+}
+
+func (s *containerStore) setDetails(id string, details *docker.ContainerInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.containers[id]; exists {
+		s.details[id] = details
+	}
+}
+
+func (s *containerStore) getDetails(id string) (*docker.ContainerInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	details, exists := s.details[id]
+	return details, exists
+}
+
+// invalidate drops the cached details of a container so they are fetched
+// again on the next render.
+func (s *containerStore) invalidate(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.details, id)
+}
+
+func (s *containerStore) setStats(stats docker.ContainerStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.containers[stats.ID]; exists {
+		s.stats[stats.ID] = stats
+	}
+}
+
+func (s *containerStore) clearStats(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.stats, id)
+}
+
+func (s *containerStore) getStats(id string) (docker.ContainerStats, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, exists := s.stats[id]
+	return stats, exists
+}
+
+// startFetch marks the details of a container as being fetched. It returns
+// false when a fetch is already in flight.
+func (s *containerStore) startFetch(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fetching[id] {
+		return false
+	}
+	s.fetching[id] = true
+	return true
+}
+
+func (s *containerStore) finishFetch(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.fetching, id)
+}
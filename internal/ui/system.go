@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+	"main/internal/docker"
+
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var diskUsageHeaders = []string{"Type", "Total", "Active", "Size", "Reclaimable"}
+
+type pruneAction struct {
+	subject string
+	message string
+	noun    string
+	run     func() (docker.PruneReport, error)
+}
+
+// pruneActions are the prunes of the disk usage screen by key.
+var pruneActions = map[rune]pruneAction{
+	'c': {"stopped containers", "This will delete all stopped containers!", "containers", func() (docker.PruneReport, error) {
+		return dockerClient.PruneContainers()
+	}},
+	'i': {"dangling images", "This will delete all untagged images without containers!", "images", func() (docker.PruneReport, error) {
+		return dockerClient.PruneImages(false)
+	}},
+	'I': {"unused images", "This will delete all images without containers!", "images", func() (docker.PruneReport, error) {
+		return dockerClient.PruneImages(true)
+	}},
+	'v': {"unused volumes", "This will delete all volumes without containers, including their data!", "volumes", func() (docker.PruneReport, error) {
+		return dockerClient.PruneVolumes()
+	}},
+	'b': {"build cache", "This will delete the whole unused build cache!", "cache entries", func() (docker.PruneReport, error) {
+		return dockerClient.PruneBuildCache()
+	}},
+}
+
+func DrawDiskUsage() {
+	table := setupResourceTable("Disk usage", diskUsageHeaders)
+	table.SetBorder(true)
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		return handleDiskUsageInput(event, table)
+	})
+
+	flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(createNotification(), 3, 1, false).
+		AddItem(CreateFooterDiskUsage().TextView, 1, 1, false)
+
+	updateDiskUsageTable(table)
+
+	app.SetRoot(flex, true).SetFocus(table)
+}
+
+func updateDiskUsageTable(table *tview.Table) {
+	usage, err := dockerClient.GetDiskUsage()
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+
+	table.Clear()
+	setTableHeaders(table, diskUsageHeaders)
+
+	var totalSize, totalReclaimable int64
+	for i, summary := range usage {
+		row := i + 1
+		table.SetCell(row, 0, tview.NewTableCell(summary.Type).SetReference(summary.Type))
+		table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%d", summary.Total)))
+		table.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("%d", summary.Active)))
+		table.SetCell(row, 3, tview.NewTableCell(units.HumanSize(float64(summary.Size))))
+		table.SetCell(row, 4, tview.NewTableCell(formatReclaimable(summary.Reclaimable, summary.Size)))
+		totalSize += summary.Size
+		totalReclaimable += summary.Reclaimable
+	}
+
+	row := len(usage) + 1
+	table.SetCell(row, 0, tview.NewTableCell("[::b]Total[::B]"))
+	table.SetCell(row, 1, tview.NewTableCell(""))
+	table.SetCell(row, 2, tview.NewTableCell(""))
+	table.SetCell(row, 3, tview.NewTableCell(fmt.Sprintf("[::b]%s[::B]", units.HumanSize(float64(totalSize)))))
+	table.SetCell(row, 4, tview.NewTableCell(formatReclaimable(totalReclaimable, totalSize)))
+
+	if row, _ := table.GetSelection(); row < 1 {
+		table.Select(1, 0)
+	}
+}
+
+func formatReclaimable(reclaimable, size int64) string {
+	text := units.HumanSize(float64(reclaimable))
+	if size > 0 {
+		text += fmt.Sprintf(" (%d%%)", reclaimable*100/size)
+	}
+	if reclaimable > 0 {
+		return "[yellow]" + text + "[white]"
+	}
+	return text
+}
+
+func handleDiskUsageInput(event *tcell.EventKey, table *tview.Table) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		DrawHome()
+		return nil
+	case tcell.KeyRune:
+		if event.Rune() == 'a' {
+			showPruneAllConfirmation(table)
+			return nil
+		}
+		if action, exists := pruneActions[event.Rune()]; exists {
+			showPruneConfirmation(table, action)
+			return nil
+		}
+	}
+	return event
+}
+
+func showPruneConfirmation(table *tview.Table, action pruneAction) {
+	showConfirmationModal("PRUNE", action.subject, action.message, func() {
+		report, err := action.run()
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("Removed %d %s, reclaimed %s",
+			report.Deleted, action.noun, units.HumanSize(float64(report.SpaceReclaimed))))
+		app.QueueUpdateDraw(func() {
+			updateDiskUsageTable(table)
+		})
+	}, 60, 10)
+}
+
+func showPruneAllConfirmation(table *tview.Table) {
+	message := "This will delete all stopped containers, unused images, unused volumes and the build cache!"
+	showConfirmationModal("PRUNE", "everything unused", message, func() {
+		var reclaimed uint64
+		for _, key := range []rune{'c', 'I', 'v', 'b'} {
+			report, err := pruneActions[key].run()
+			if err != nil {
+				NotificationError(fmt.Errorf("pruning %s failed after reclaiming %s: %w",
+					pruneActions[key].subject, units.HumanSize(float64(reclaimed)), err))
+				app.QueueUpdateDraw(func() {
+					updateDiskUsageTable(table)
+				})
+				return
+			}
+			reclaimed += report.SpaceReclaimed
+		}
+		NotificationSuccess(fmt.Sprintf("Reclaimed %s", units.HumanSize(float64(reclaimed))))
+		app.QueueUpdateDraw(func() {
+			updateDiskUsageTable(table)
+		})
+	}, 60, 11)
+}
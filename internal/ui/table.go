@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func setupResourceTable(title string, headers []string) *tview.Table {
+	table := tview.NewTable().SetSelectable(true, false)
+	table.SetTitle(title)
+	table.SetBorderPadding(0, 0, 1, 1)
+	table.SetBackgroundColor(tcell.GetColor(userTheme.Table.Fg))
+	table.SetSelectedStyle(tcell.StyleDefault.Background(tcell.GetColor(userTheme.Table.Selected)))
+	setTableHeaders(table, headers)
+	return table
+}
+
+func setTableHeaders(table *tview.Table, headers []string) {
+	for i, header := range headers {
+		table.SetCell(0, i, tview.NewTableCell(fmt.Sprintf("[-:-:b]%s[-:-:B]", header)).
+			SetTextColor(tcell.GetColor(userTheme.Table.Headers)).
+			SetExpansion(1).
+			SetSelectable(false))
+	}
+}
+
+// selectedReference returns the reference stored in the first cell of the
+// selected row, or an empty string when nothing is selected.
+func selectedReference(table *tview.Table) string {
+	row, _ := table.GetSelection()
+	if row < 1 || row >= table.GetRowCount() {
+		return ""
+	}
+	reference, _ := table.GetCell(row, 0).GetReference().(string)
+	return reference
+}
+
+func shortID(id string) string {
+	id = strings.TrimPrefix(id, "sha256:")
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+func drawTextScreen(title, text string, onBack func()) {
+	textView := tview.NewTextView().SetDynamicColors(true).SetText(text)
+	textView.SetBorder(true)
+	textView.SetTitle(fmt.Sprintf("  %s - Press [orange:-:b]ESC[white:-:B] to go back  ", title))
+
+	textView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			onBack()
+			return nil
+		}
+		return event
+	})
+
+	app.SetRoot(textView, true).SetFocus(textView)
+}
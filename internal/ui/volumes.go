@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-units"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var (
+	volumeHeaders    = []string{"Name", "Driver", "Mountpoint", "Labels", "Size", "Refs", "Containers"}
+	showOnlyDangling bool
+)
+
+func DrawVolumes() {
+	table := createVolumeList()
+
+	flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(createNotification(), 3, 1, false).
+		AddItem(CreateFooterVolumes().TextView, 1, 1, false)
+
+	app.SetRoot(flex, true).SetFocus(table)
+}
+
+func createVolumeList() *tview.Table {
+	table := setupResourceTable("Volumes", volumeHeaders)
+	updateVolumeTable(table)
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		return handleVolumeInput(event, table)
+	})
+
+	return table
+}
+
+func updateVolumeTable(table *tview.Table) {
+	volumes := dockerClient.GetDockerVolumes()
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+
+	usage := dockerClient.GetVolumeUsage()
+	volumeContainers := dockerClient.GetVolumeContainers()
+
+	table.Clear()
+	setTableHeaders(table, volumeHeaders)
+
+	row := 1
+	for _, vol := range volumes {
+		containers := volumeContainers[vol.Name]
+		if showOnlyDangling && len(containers) > 0 {
+			continue
+		}
+
+		size, refs := "-", "-"
+		if data, exists := usage[vol.Name]; exists && data != nil {
+			if data.Size >= 0 {
+				size = units.HumanSize(float64(data.Size))
+			}
+			if data.RefCount >= 0 {
+				refs = fmt.Sprintf("%d", data.RefCount)
+			}
+		}
+
+		var names []string
+		for _, container := range containers {
+			names = append(names, strings.TrimPrefix(container.Names[0], "/"))
+		}
+
+		table.SetCell(row, 0, tview.NewTableCell(vol.Name).SetReference(vol.Name))
+		table.SetCell(row, 1, tview.NewTableCell(vol.Driver))
+		table.SetCell(row, 2, tview.NewTableCell(vol.Mountpoint))
+		table.SetCell(row, 3, tview.NewTableCell(formatKeyValues(vol.Labels)))
+		table.SetCell(row, 4, tview.NewTableCell(size))
+		table.SetCell(row, 5, tview.NewTableCell(refs))
+		table.SetCell(row, 6, tview.NewTableCell(strings.Join(names, ", ")))
+		row++
+	}
+
+	if showOnlyDangling {
+		table.SetTitle("Volumes (dangling)")
+	} else {
+		table.SetTitle("Volumes")
+	}
+
+	if table.GetRowCount() > 1 {
+		table.Select(1, 0)
+	}
+}
+
+func handleVolumeInput(event *tcell.EventKey, table *tview.Table) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		DrawHome()
+		return nil
+	case tcell.KeyEnter:
+		showVolumeAttributes(table)
+		return nil
+	case tcell.KeyCtrlD:
+		showRemoveVolumeConfirmation(table)
+		return nil
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'a':
+			showVolumeAttributes(table)
+			return nil
+		case 'n':
+			showCreateVolumeForm(table)
+			return nil
+		case 'f':
+			showOnlyDangling = !showOnlyDangling
+			updateVolumeTable(table)
+			return nil
+		}
+	}
+	return event
+}
+
+func showVolumeAttributes(table *tview.Table) {
+	name := selectedReference(table)
+	if name == "" {
+		return
+	}
+
+	attributes, err := highlightJSON(dockerClient.GetVolumeAttributes(name))
+	if err != nil {
+		NotificationError(err)
+		return
+	}
+	drawTextScreen(name, attributes, DrawVolumes)
+}
+
+func showCreateVolumeForm(table *tview.Table) {
+	form := tview.NewForm().
+		AddInputField("Name", "", 40, nil, nil).
+		AddInputField("Driver", "local", 40, nil, nil).
+		AddInputField("Labels", "", 40, nil, nil)
+
+	form.AddButton("Create", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		driver := form.GetFormItemByLabel("Driver").(*tview.InputField).GetText()
+		labels, err := parseKeyValues(form.GetFormItemByLabel("Labels").(*tview.InputField).GetText())
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+
+		closeModal()
+		go func() {
+			created, err := dockerClient.CreateVolume(name, driver, labels)
+			if err != nil {
+				NotificationError(err)
+				return
+			}
+			NotificationSuccess(fmt.Sprintf("Created volume %s", created))
+			app.QueueUpdateDraw(func() {
+				updateVolumeTable(table)
+			})
+		}()
+	})
+	form.AddButton("Cancel", closeModal)
+
+	showFormModal("Create volume", form, 60, 11)
+}
+
+func showRemoveVolumeConfirmation(table *tview.Table) {
+	name := selectedReference(table)
+	if name == "" {
+		return
+	}
+
+	showConfirmationModal("REMOVE", name, "This will delete the volume and its data!", func() {
+		err := dockerClient.RemoveVolume(name, false)
+		if err != nil {
+			NotificationError(err)
+			return
+		}
+		NotificationSuccess(fmt.Sprintf("Removed %s", name))
+		app.QueueUpdateDraw(func() {
+			updateVolumeTable(table)
+		})
+	}, 60, 10)
+}